@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// generateDirective is the directive inserted by Init.
+const generateDirective = "//go:generate stubber"
+
+// Init inserts a go:generate directive for stubber above the first interface
+// declaration of each file in inputDirs that declares an interface and
+// doesn't already have such a directive.
+func Init(inputDirs []string) {
+	for _, dir := range inputDirs {
+		filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, filename := range filenames {
+			if strings.HasSuffix(filename, "_test.go") || isGenerated(filename) {
+				continue
+			}
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				log.Fatalf("cannot read %s: %s", filename, err)
+			}
+			code, ok := insertDirective(filename, src)
+			if !ok {
+				continue
+			}
+			log.Printf("adding go:generate directive to %s", filename)
+			if err := ioutil.WriteFile(filename, code, 0644); err != nil {
+				log.Fatalf("failed to write %s: %s", filename, err)
+			}
+		}
+	}
+}
+
+// insertDirective returns src with a go:generate directive inserted above
+// its first interface declaration, and false if src declares no interfaces
+// or already has the directive.
+func insertDirective(filename string, src []byte) ([]byte, bool) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		log.Fatalf("cannot parse %s: %s", filename, err)
+	}
+
+	for _, group := range f.Comments {
+		for _, c := range group.List {
+			if c.Text == generateDirective || strings.HasPrefix(c.Text, generateDirective+" ") {
+				return nil, false
+			}
+		}
+	}
+
+	decl := firstInterfaceDecl(f)
+	if decl == nil {
+		return nil, false
+	}
+	// Insert the directive above the declaration's doc comment, separated by
+	// a blank line so that it doesn't become part of the documentation.
+	pos := decl.Pos()
+	if decl.Doc != nil {
+		pos = decl.Doc.Pos()
+	}
+	offset := fset.Position(pos).Offset
+
+	var buf bytes.Buffer
+	buf.Write(src[:offset])
+	buf.WriteString(generateDirective + "\n\n")
+	buf.Write(src[offset:])
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting %s: %s", filename, err)
+	}
+	return code, true
+}
+
+// firstInterfaceDecl returns the first type declaration in f that declares
+// an interface, or nil if there isn't one.
+func firstInterfaceDecl(f *ast.File) *ast.GenDecl {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if _, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType); ok {
+				return gen
+			}
+		}
+	}
+	return nil
+}
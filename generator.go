@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultStyle is the name of the Generator used when Options.Style isn't
+// set, which produces stubber's own style of stubs.
+const DefaultStyle = "default"
+
+// Generator produces the source of the stubs for the interfaces of a Package,
+// allowing output formats other than stubber's own to be added without
+// changing Main. A Generator is registered under a style name with
+// RegisterGenerator, and is selected by setting Options.Style to that name.
+type Generator interface {
+	// Generate returns the Go source of the stubs for p's interfaces, which
+	// is formatted before it's written.
+	Generate(p *Package) ([]byte, error)
+}
+
+// GeneratorFunc adapts an ordinary function to a Generator.
+type GeneratorFunc func(p *Package) ([]byte, error)
+
+func (f GeneratorFunc) Generate(p *Package) ([]byte, error) {
+	return f(p)
+}
+
+var generators = map[string]Generator{
+	DefaultStyle: GeneratorFunc(func(p *Package) ([]byte, error) {
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, p); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}),
+	TestifyStyle: GeneratorFunc(generateTestify),
+}
+
+// RegisterGenerator makes g available under the given style name. It panics
+// if g is nil or if a Generator is already registered under style.
+func RegisterGenerator(style string, g Generator) {
+	if g == nil {
+		panic("stubber: RegisterGenerator generator is nil")
+	}
+	if _, ok := generators[style]; ok {
+		panic("stubber: RegisterGenerator called twice for style " + style)
+	}
+	generators[style] = g
+}
+
+// Styles returns the sorted names of the registered generators.
+func Styles() []string {
+	styles := make([]string, 0, len(generators))
+	for style := range generators {
+		styles = append(styles, style)
+	}
+	sort.Strings(styles)
+	return styles
+}
+
+// lookupGenerator returns the Generator registered under style.
+func lookupGenerator(style string) (Generator, error) {
+	g, ok := generators[style]
+	if !ok {
+		return nil, fmt.Errorf("unknown style %s, must be one of: %s", style, strings.Join(Styles(), ", "))
+	}
+	return g, nil
+}
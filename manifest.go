@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+)
+
+// ManifestEntry describes a stub generated for an interface, as listed in
+// the manifest written when Options.Manifest is set.
+type ManifestEntry struct {
+	// Interface is the name of the stubbed interface, or of the concrete type
+	// whose method set was stubbed.
+	Interface string `json:"interface"`
+	// Package is the import path of the package declaring it.
+	Package string `json:"package"`
+	// Impl is the name of the generated stub.
+	Impl string `json:"impl"`
+	// File is the file the stub was written to, or empty if it was written
+	// to standard output.
+	File string `json:"file,omitempty"`
+	// Methods lists the names of the stubbed methods.
+	Methods []string `json:"methods"`
+}
+
+// manifestEntries returns the entries describing the stubs generated for
+// pkgs, sorted by package and interface name. toFiles is set if the stubs
+// were written to files rather than standard output.
+func manifestEntries(pkgs []*Package, toFiles bool) []ManifestEntry {
+	entries := []ManifestEntry{}
+	for _, pkg := range pkgs {
+		for _, iface := range pkg.Interfaces {
+			entry := ManifestEntry{
+				Interface: iface.Name,
+				Package:   canonicalPath(iface.Source.PkgPath),
+				Impl:      iface.ImplName(),
+				Methods:   make([]string, len(iface.Funcs)),
+			}
+			if toFiles {
+				entry.File = pkg.Filename()
+			}
+			for i, f := range iface.Funcs {
+				entry.Methods[i] = f.Name
+			}
+			sort.Strings(entry.Methods)
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Package != entries[j].Package {
+			return entries[i].Package < entries[j].Package
+		}
+		return entries[i].Interface < entries[j].Interface
+	})
+	return entries
+}
+
+// writeManifest writes the manifest of the stubs generated for pkgs to
+// opts.Manifest.
+func writeManifest(pkgs []*Package, toFiles bool, opts Options) {
+	data, err := json.MarshalIndent(manifestEntries(pkgs, toFiles), "", "\t")
+	if err != nil {
+		log.Fatalf("failed to encode manifest: %s", err)
+	}
+	writeFile(opts.Manifest, append(data, '\n'), opts)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ModuleMocks returns the directory of every package in the module
+// containing dir that stubs can be generated for, along with the default
+// output directory for them: the module's internal/mocks package. Main
+// packages, which can't be imported, are left out, as is the output
+// directory itself.
+func ModuleMocks(dir string) (inputDirs []string, outputDir string) {
+	cmd := exec.Command("go", "env", "GOMOD")
+	cmd.Dir = dir
+	gomod, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("cannot find module for %s: %s", dir, err)
+	}
+	if path := strings.TrimSpace(string(gomod)); path == "" || path == "/dev/null" {
+		log.Fatalf("%s is not in a module", dir)
+	} else {
+		dir = filepath.Dir(path)
+	}
+	outputDir = filepath.Join(dir, "internal", "mocks")
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles,
+		Dir:  dir,
+	}, "./...")
+	if err != nil {
+		log.Fatalf("cannot list packages in %s: %s", dir, err)
+	}
+	for _, pkg := range pkgs {
+		if pkg.Name == "main" || len(pkg.GoFiles) == 0 {
+			continue
+		}
+		pkgDir := filepath.Dir(pkg.GoFiles[0])
+		if pkgDir == outputDir {
+			continue
+		}
+		inputDirs = append(inputDirs, pkgDir)
+	}
+	return inputDirs, outputDir
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// isRecursivePattern reports whether input is a package pattern such as
+// "./..." that matches a directory and everything beneath it.
+func isRecursivePattern(input string) bool {
+	return input == "..." || strings.HasSuffix(input, "/...")
+}
+
+// ExpandPattern returns the directory of every package matched by pattern,
+// which ends in "/...", along with the output directory for each: outputDir
+// joined with the package's path beneath the pattern's root, so that the
+// stubs mirror the layout of the packages. If outputDir is empty, so are the
+// output directories. Main packages, which can't be imported, are left out,
+// as are packages within outputDir when it's beneath the pattern's root.
+func ExpandPattern(pattern, outputDir string, opts Options) (inputDirs, outputDirs []string) {
+	root := strings.TrimSuffix(strings.TrimSuffix(pattern, "..."), "/")
+	local := !isImportPath(root) || root == ""
+	if local {
+		if root == "" {
+			root = "."
+		}
+		var err error
+		if root, err = filepath.Abs(root); err != nil {
+			log.Fatalf("failed to resolve %s: %s", pattern, err)
+		}
+	}
+	var absOutputDir string
+	if outputDir != "" {
+		var err error
+		if absOutputDir, err = filepath.Abs(outputDir); err != nil {
+			log.Fatalf("failed to resolve %s: %s", outputDir, err)
+		}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles,
+		BuildFlags: []string{"-tags=" + strings.Join(opts.loadTags(), ",")},
+	}, pattern)
+	if err != nil {
+		log.Fatalf("cannot list packages matching %s: %s", pattern, err)
+	}
+	for _, pkg := range pkgs {
+		if pkg.Name == "main" || len(pkg.GoFiles) == 0 {
+			continue
+		}
+		pkgDir := filepath.Dir(pkg.GoFiles[0])
+		if absOutputDir != "" && absOutputDir != root && within(pkgDir, absOutputDir) {
+			continue
+		}
+		var rel string
+		if local {
+			if rel, err = filepath.Rel(root, pkgDir); err != nil {
+				log.Fatalf("failed to resolve %s: %s", pkgDir, err)
+			}
+		} else {
+			rel = filepath.FromSlash(strings.TrimPrefix(strings.TrimPrefix(pkg.PkgPath, root), "/"))
+		}
+		inputDirs = append(inputDirs, pkgDir)
+		if outputDir == "" {
+			outputDirs = append(outputDirs, "")
+		} else {
+			outputDirs = append(outputDirs, filepath.Join(outputDir, rel))
+		}
+	}
+	if len(inputDirs) == 0 {
+		log.Fatalf("no packages to stub match %s", pattern)
+	}
+	return inputDirs, outputDirs
+}
+
+// within reports whether dir is parent or a directory beneath it.
+func within(dir, parent string) bool {
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
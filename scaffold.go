@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Recording is a serialized set of calls made to an interface, such as one
+// captured from a legacy implementation, from which a characterization test
+// can be scaffolded.
+type Recording struct {
+	// Interface is the name of the recorded interface, optionally qualified
+	// by its package name, e.g. "bank.Account".
+	Interface string `json:"interface"`
+	// Calls lists the recorded calls in the order they were made.
+	Calls []RecordedCall `json:"calls"`
+}
+
+// RecordedCall is a single call within a Recording.
+type RecordedCall struct {
+	// Method is the name of the method that was called.
+	Method string `json:"method"`
+	// Args is the JSON encoding of the call's parameters, in the same form
+	// as an element of the stub's Calls accessor.
+	Args json.RawMessage `json:"args"`
+}
+
+var scaffoldTemplate = template.Must(template.New("").Parse(`// This file was scaffolded by stubber from recorded calls. Fill in the TODOs
+// to exercise the code under test.
+{{range .Pkg.BuildLines}}
+{{.}}
+{{- end}}
+
+package {{.Pkg.OutputName}}
+
+import (
+	{{range $pkg, $alias := .Imports.Dependencies}}{{with $alias}}{{.}} {{end}}"{{$pkg}}"
+	{{end}}
+)
+
+{{range .Tests}}
+// Test{{.Interface.ImplName}}Recording checks that the calls made to a
+// {{.Interface.ImplName}} match those recorded from {{.Interface.QualName}}.
+func Test{{.Interface.ImplName}}Recording(t *{{$.Testing}}.T) {
+	s := &{{.Interface.ImplName}}{
+		{{range .Calls}}{{.Func.StubName}}: func{{.Func.ParamsString}} {{.Func.BlankResultsString}} {
+			return // TODO: return the recorded results
+		},
+		{{end}}
+	}
+
+	// TODO: exercise the code under test using s.
+
+	for _, c := range []struct {
+		method string
+		calls  interface{}
+		want   string
+	}{
+		{{range .Calls}}{"{{.Func.Name}}", s.{{.Func.CallsName true}}(), {{.Want}}},
+		{{end}}
+	} {
+		got, err := {{$.JSON}}.Marshal(c.calls)
+		if err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		var gotCalls, wantCalls interface{}
+		if err := {{$.JSON}}.Unmarshal(got, &gotCalls); err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		if err := {{$.JSON}}.Unmarshal([]byte(c.want), &wantCalls); err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		if !{{$.Reflect}}.DeepEqual(gotCalls, wantCalls) {
+			t.Errorf("%s: calls mismatch\n got: %s\nwant: %s", c.method, got, c.want)
+		}
+	}
+}
+{{end}}
+`))
+
+type scaffoldData struct {
+	Pkg *Package
+	// Imports holds the packages imported by the scaffolded test.
+	Imports *Package
+	// JSON, Reflect and Testing are the names by which the test refers to
+	// the packages it uses itself, which are aliased if a package used by
+	// the stubs has the same name.
+	JSON, Reflect, Testing string
+	Tests                  []scaffoldTest
+}
+
+type scaffoldTest struct {
+	Interface *Interface
+	Calls     []scaffoldCalls
+}
+
+// scaffoldCalls holds every recorded call to a single method.
+type scaffoldCalls struct {
+	Func *Func
+	// Want is a Go string literal holding the expected calls as a JSON array.
+	Want string
+}
+
+// scaffold returns the formatted source of a test exercising the recorded
+// calls to p's interfaces, or nil if none of them were recorded.
+func scaffold(p *Package, recordings []Recording) []byte {
+	data := scaffoldData{Pkg: p}
+	deps := make(map[string]string)
+
+	for _, rec := range recordings {
+		var iface *Interface
+		for _, i := range p.Interfaces {
+			if rec.Interface == i.QualName || rec.Interface == i.Name {
+				iface = i
+				break
+			}
+		}
+		if iface == nil {
+			continue
+		}
+		if iface.TypeParams.Len() > 0 {
+			log.Printf("cannot scaffold a test for generic interface %s", iface.QualName)
+			continue
+		}
+
+		test := scaffoldTest{Interface: iface}
+		args := make(map[string][]string)
+		for _, call := range rec.Calls {
+			var f *Func
+			for i := range iface.Funcs {
+				if iface.Funcs[i].Name == call.Method {
+					f = &iface.Funcs[i]
+					break
+				}
+			}
+			if f == nil {
+				log.Fatalf("recording of %s references unknown method %s", rec.Interface, call.Method)
+			}
+
+			if _, ok := args[f.Name]; !ok {
+				test.Calls = append(test.Calls, scaffoldCalls{Func: f})
+				for j := 0; j < f.Signature.Params().Len(); j++ {
+					collectDependencies(f.Signature.Params().At(j).Type(), deps)
+				}
+				for j := 0; j < f.Signature.Results().Len(); j++ {
+					collectDependencies(f.Signature.Results().At(j).Type(), deps)
+				}
+			}
+
+			var buf bytes.Buffer
+			if len(call.Args) == 0 {
+				buf.WriteString("{}")
+			} else if err := json.Compact(&buf, call.Args); err != nil {
+				log.Fatalf("invalid args recorded for %s.%s: %s", rec.Interface, call.Method, err)
+			}
+			args[f.Name] = append(args[f.Name], buf.String())
+		}
+
+		for i := range test.Calls {
+			test.Calls[i].Want = goString("[" + strings.Join(args[test.Calls[i].Func.Name], ",") + "]")
+		}
+		data.Tests = append(data.Tests, test)
+	}
+
+	if len(data.Tests) == 0 {
+		return nil
+	}
+	// The scaffolded test refers to types in the same way as the stubs, so
+	// it imports their packages with the same aliases. Like the stubs, a test
+	// written into the input package refers to its types directly. Its own
+	// packages are then imported in the same way as those of the stubs.
+	data.Imports = &Package{
+		Dependencies:    make(map[string]string),
+		DependencyNames: make(map[string]struct{}),
+		importNames:     make(map[string]string),
+	}
+	for _, importPath := range sortedKeys(deps) {
+		if p.InPackage && importPath == canonicalPath(p.Pkg.PkgPath) {
+			continue
+		}
+		name := p.importNames[importPath]
+		data.Imports.Dependencies[importPath] = p.Dependencies[importPath]
+		data.Imports.DependencyNames[name] = struct{}{}
+		data.Imports.importNames[importPath] = name
+	}
+	for _, importPath := range []string{"encoding/json", "reflect", "testing"} {
+		data.Imports.addImport(importPath, path.Base(importPath))
+	}
+	data.JSON = data.Imports.importNames["encoding/json"]
+	data.Reflect = data.Imports.importNames["reflect"]
+	data.Testing = data.Imports.importNames["testing"]
+
+	var buf bytes.Buffer
+	if err := scaffoldTemplate.Execute(&buf, data); err != nil {
+		log.Fatal(err)
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Print(sourceContext(buf.Bytes(), err))
+		log.Fatalf("error formatting scaffolded test: %s", err)
+	}
+	return code
+}
+
+// goString returns s as a Go string literal, preferring a raw string.
+func goString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
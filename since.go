@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ChangedSince returns the directories in inputDirs that contain a Go file,
+// other than generated stubs, that differs from the git ref.
+// Only a package's own files are considered, so a package isn't regenerated
+// when only a package it depends on has changed.
+func ChangedSince(ref string, inputDirs []string) []string {
+	top := git(inputDirs[0], "rev-parse", "--show-toplevel")
+	changed := make(map[string]bool)
+	for _, name := range strings.Split(git(top, "diff", "--name-only", ref, "--", "*.go"), "\n") {
+		if name == "" || isGenerated(name) {
+			continue
+		}
+		changed[filepath.Dir(filepath.Join(top, name))] = true
+	}
+
+	var result []string
+	for _, dir := range inputDirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// Resolve symlinks, since git reports the real path of the top level.
+		if realDir, err := filepath.EvalSymlinks(absDir); err == nil {
+			absDir = realDir
+		}
+		if changed[absDir] {
+			result = append(result, dir)
+		}
+	}
+	return result
+}
+
+// git runs git with args in dir and returns its trimmed output.
+func git(dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("git %s: %s\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -66,68 +66,699 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/format"
+	"go/scanner"
 	"go/token"
 	"go/types"
-	"html/template"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/template"
 	"unicode"
 
 	"golang.org/x/tools/go/packages"
 )
 
 var (
-	t = template.Must(template.New("").Parse(`// This file was generated by stubber; DO NOT EDIT
-
-// +build !nostubs
+	t = template.Must(template.New("").Parse(`{{.HeaderComment}}
+{{range .BuildLines}}
+{{.}}
+{{- end}}
 	
 package {{.OutputName}}
-
+{{if .Imports}}
 import (
-	{{range $pkg, $empty := .Dependencies}}"{{$pkg}}"
+	{{range .Imports}}{{with .Alias}}{{.}} {{end}}"{{.Path}}"
 	{{end}}
 )
-
+{{end}}
 {{range $interface := .Interfaces}}
+{{if $.Options.Regions}}//region {{.ImplName}}
+
+{{end -}}
+{{- if .Concrete}}
+// {{.InterfaceName}} is the method set of {{.QualName}}, which {{.ImplName}}
+// implements.
+type {{.InterfaceName}} interface {
+	{{- range .Funcs}}
+	{{.Name}}{{.ParamsString}} {{.ResultsString}}
+	{{- end}}
+}
+
+{{end -}}
 // {{.ImplName}} is a stubbed implementation of {{.QualName}}.
-type {{.ImplName}} struct {
+{{- if and $.Options.Examples .Funcs}}{{with index .Funcs 0}}
+//
+// For example, to stub {{.Name}}:
+//
+//	{{.Receiver}} := &{{$interface.TypeName}}{
+//		{{.StubName}}: func{{.ParamsString}}{{with .ResultsString}} {{.}}{{end}} {
+//			{{if .HasResults}}return {{.ZeroResults}}{{else}}// ...{{end}}
+//		},
+//	}
+{{- end}}{{end}}
+type {{.ImplName}}{{.TypeParamsDecl}} struct {
+	{{- if eq $.Options.Unexpected "fail"}}
+	// {{.TBName}}, if set, is used to report calls to methods without a stub.
+	{{.TBName}} testing.TB
+	{{- end}}
+	{{- if $.Options.Sink}}
+	// {{.SinkName}}, if set, is sent an event for every call made to the stub.
+	{{.SinkName}} func(support.CallEvent)
+	{{- end}}
+	{{- if $.Options.Spy}}
+	// {{.RealName}} is the implementation that methods without a stub delegate to.
+	{{.RealName}} {{.InterfaceName}}{{.TypeArgs}}
+	{{- end}}
+	{{- if $.Options.CallLog}}
+	{{.LogName}} []string
+	{{- end}}
+	{{- if $.Options.Concurrent}}
+	{{.MutexName}} sync.Mutex
+	{{- end}}
+	{{- if $.Options.Gomock}}
+	{{.RecorderFieldName}} *{{.RecorderName}}{{.TypeArgs}}
+	{{- end}}
 	{{range .Funcs -}}
 	// {{.StubName}} defines the implementation for {{.Name}}.
-	{{.StubName}} func{{.ParamsString}} {{.ResultsString}}
+	{{.StubName}} {{.StubType}}{{.StubTag}}
+	{{- if $.Options.CallStore}}
+	// {{.CallStoreName}}, if set, stores the calls made to {{.Name}}. It
+	// defaults to a support.SliceStore.
+	{{.CallStoreName}} support.CallStore[{{.ParamsStruct}}]
+	{{- else if not $.Options.ValueReceiver}}
 	{{.CallsName false}} []{{.ParamsStruct}}
+	{{- if $.Options.MaxCalls}}
+	{{.CallTotalName}} int
+	{{- end}}
+	{{- end}}
+	{{- if $.Options.PanicToggles}}
+	// {{.AllowNilName}}, if true, makes {{.Name}} return zero values when
+	// it's called without a stub.
+	{{.AllowNilName}} bool
+	{{- end}}
+	{{- if $.Options.RecoverPanics}}
+	// {{.PanicsName}} holds the values of any panics raised by {{.StubName}}.
+	{{.PanicsName}} []interface{}
+	{{- end}}
+	{{- if .CanFail}}
+	// {{.FailAfterName}}, if positive, is the number of calls to {{.Name}} that
+	// succeed before it starts failing with {{.FailErrorName}}.
+	{{.FailAfterName}} int
+	// {{.FailErrorName}} is the error returned by {{.Name}} once
+	// {{.FailAfterName}} calls have been made.
+	{{.FailErrorName}} error
+	{{- end}}
+	{{- if .CanValidate}}
+	// {{.ValidateName}}, if set, is called with the arguments of each call to
+	// {{.Name}} before its stub. A non-nil error is {{if .ReturnsError}}returned by {{.Name}}{{else}}raised as a panic{{end}}.
+	{{.ValidateName}} func{{.ParamsString}} error
+	{{- end}}
+	{{- if .CanReturn}}
+	// {{.ReturnsName}} holds results returned, in order, by calls to {{.Name}}
+	// while {{.StubName}} is nil. Each one is removed once it's returned.
+	{{.ReturnsName}} []{{.ResultsStruct}}
+	{{- end}}
 	{{end}}
 }
 
+// {{.ConstructorName}} returns a new {{.ImplName}} without any stubs set.
+func {{.ConstructorName}}{{.TypeParamsDecl}}() *{{.TypeName}} {
+	return &{{.TypeName}}{}
+}
+{{if $.Options.NamedFuncs}}{{range .Funcs}}
+// {{.FuncTypeName}} is the signature of {{$interface.QualName}}.{{.Name}}.
+type {{.FuncTypeName}}{{$interface.TypeParamsDecl}} func{{.ParamsString}} {{.ResultsString}}
+{{end}}{{end}}
 {{range .Funcs}}
-// {{.Name}} delegates its behavior to the field {{.StubName}}.
-func (s *{{$interface.ImplName}}) {{.Name}}{{.ParamsString}} {{.ResultsString}} {
-	if s.{{.StubName}} == nil {
-		panic("{{$interface.ImplName}}.{{.Name}}: nil method stub")
+{{.DocComment}}// {{.Name}} delegates its behavior to the field {{.StubName}}.
+func ({{.Receiver}} {{$interface.ReceiverType}}) {{.Name}}{{.ParamsString}} {{.MethodResultsString}} {
+	{{- if and (ne $.Options.ZeroValue "noop") (not .CanFail) (not .CanReturn)}}
+	{{template "nilstub" .}}
+	{{- end}}
+	{{- if and $.Options.Concurrent (or (not .RecordsReturns) $.Options.CallLog)}}
+	{{.Receiver}}.{{$interface.MutexName}}.Lock()
+	{{- end}}
+	{{- if $.Options.CallStore}}
+	support.Record(&{{.Receiver}}.{{.CallStoreName}}, {{.ParamsStruct}}{ {{.ParamsStructValues}} })
+	{{- else if $.Options.MaxCalls}}
+	if {{.Receiver}}.{{.CallsName false}} == nil {
+		{{.Receiver}}.{{.CallsName false}} = make([]{{.ParamsStruct}}, 0, {{$.Options.MaxCalls}})
+	}
+	if len({{.Receiver}}.{{.CallsName false}}) < {{$.Options.MaxCalls}} {
+		{{.Receiver}}.{{.CallsName false}} = append({{.Receiver}}.{{.CallsName false}}, {{.ParamsStruct}}{ {{.ParamsStructValues}} })
+	} else {
+		{{.Receiver}}.{{.CallsName false}}[{{.Receiver}}.{{.CallTotalName}}%{{$.Options.MaxCalls}}] = {{.ParamsStruct}}{ {{.ParamsStructValues}} }
+	}
+	{{.Receiver}}.{{.CallTotalName}}++
+	{{- else if and (not $.Options.ValueReceiver) (not .RecordsReturns)}}
+	{{.Receiver}}.{{.CallsName false}} = append({{.Receiver}}.{{.CallsName false}}, {{.ParamsStruct}}{ {{.ParamsStructValues}} })
+	{{- end}}
+	{{- if $.Options.CallLog}}
+	{{.Receiver}}.{{$interface.LogName}} = append({{.Receiver}}.{{$interface.LogName}}, "{{.Name}}")
+	{{- end}}
+	{{- if and $.Options.Concurrent (or (not .RecordsReturns) $.Options.CallLog)}}
+	{{.Receiver}}.{{$interface.MutexName}}.Unlock()
+	{{- end}}
+	{{- if .RecordsReturns}}
+	defer func() {
+		{{- if $.Options.Concurrent}}
+		{{.Receiver}}.{{$interface.MutexName}}.Lock()
+		defer {{.Receiver}}.{{$interface.MutexName}}.Unlock()
+		{{- end}}
+		{{.Receiver}}.{{.CallsName false}} = append({{.Receiver}}.{{.CallsName false}}, {{.RecordedCall}})
+	}()
+	{{- end}}
+	{{- if $.Options.Sink}}
+	if {{.Receiver}}.{{$interface.SinkName}} != nil {
+		{{.Receiver}}.{{$interface.SinkName}}(support.CallEvent{Stub: "{{$interface.ImplName}}", Method: "{{.Name}}", Args: {{.LastCall}}})
+	}
+	{{- end}}
+	{{- if .CanValidate}}
+	if {{.Receiver}}.{{.ValidateName}} != nil {
+		if err := {{.Receiver}}.{{.ValidateName}}({{.ParamNames}}); err != nil {
+			{{if .ReturnsError}}return {{.ErrorResults "err"}}{{else}}panic(err){{end}}
+		}
+	}
+	{{- end}}
+	{{- if .CanFail}}
+	if {{.Receiver}}.{{.FailAfterName}} > 0 && {{.CallCount}} {{if .RecordsReturns}}>={{else}}>{{end}} {{.Receiver}}.{{.FailAfterName}} {
+		return {{.ErrorResults (printf "%s.%s" .Receiver .FailErrorName)}}
 	}
-	s.{{.CallsName false}} = append(s.{{.CallsName false}}, {{.ParamsStruct}}{ {{.ParamsStructValues}} })
-	{{if .HasResults}}return {{end}}(s.{{.StubName}})({{.ParamNames}})
+	{{- end}}
+	{{- if .CanReturn}}
+	{{.NextName}} := {{.Receiver}}.{{.NextReturnsName}}()
+	{{- end}}
+	{{- if and (ne $.Options.ZeroValue "noop") (or .CanFail .CanReturn)}}
+	{{template "nilstub" .}}
+	{{- end}}
+	{{- if $.Options.RecoverPanics}}
+	defer func() {
+		{{- $recovered := .RecoveredName}}
+		if {{$recovered}} := recover(); {{$recovered}} != nil {
+			{{- if $.Options.Concurrent}}
+			{{.Receiver}}.{{$interface.MutexName}}.Lock()
+			{{.Receiver}}.{{.PanicsName}} = append({{.Receiver}}.{{.PanicsName}}, {{$recovered}})
+			{{.Receiver}}.{{$interface.MutexName}}.Unlock()
+			{{- else}}
+			{{.Receiver}}.{{.PanicsName}} = append({{.Receiver}}.{{.PanicsName}}, {{$recovered}})
+			{{- end}}
+			panic({{$recovered}})
+		}
+	}()
+	{{- end}}
+	{{- if .CanReturn}}
+	if {{.NextName}} != nil {
+		return {{.NextResults}}
+	}
+	{{- end}}
+	{{- if eq $.Options.ZeroValue "noop"}}
+	if {{.Receiver}}.{{.StubName}} == nil{{if $.Options.Spy}} && {{.Receiver}}.{{$interface.RealName}} == nil{{end}} {
+		return {{.ZeroResults}}
+	}
+	{{- end}}
+	{{- if $.Options.Spy}}
+	if {{.Receiver}}.{{.StubName}} == nil {
+		{{if .HasResults}}return {{end}}{{.Receiver}}.{{$interface.RealName}}.{{.Name}}({{.ParamNames}})
+		{{- if not .HasResults}}
+		return
+		{{- end}}
+	}
+	{{- end}}
+	{{if .HasResults}}return {{end}}({{.Receiver}}.{{.StubName}})({{.ParamNames}})
+}
+{{- if .CanReturn}}
+
+// {{.NextReturnsName}} removes and returns the first of {{.ReturnsName}}, or
+// nil if {{.StubName}} is set or there are none left.
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.NextReturnsName}}() *{{.ResultsStruct}} {
+	if {{.Receiver}}.{{.StubName}} != nil {
+		return nil
+	}
+	{{- if $.Options.Concurrent}}
+	{{.Receiver}}.{{$interface.MutexName}}.Lock()
+	defer {{.Receiver}}.{{$interface.MutexName}}.Unlock()
+	{{- end}}
+	if len({{.Receiver}}.{{.ReturnsName}}) == 0 {
+		return nil
+	}
+	{{- $next := $.Local "next"}}
+	{{$next}} := {{.Receiver}}.{{.ReturnsName}}[0]
+	{{.Receiver}}.{{.ReturnsName}} = {{.Receiver}}.{{.ReturnsName}}[1:]
+	return &{{$next}}
 }
+{{- end}}
+{{- if not $.Options.ValueReceiver}}
 
 // {{.CallsName true}} returns a slice of calls made to {{.Name}}. Each element
 // of the slice represents the parameters that were provided.
-func (s *{{$interface.ImplName}}) {{.CallsName true}}() []{{.ParamsStruct}} {
-	return s.{{.CallsName false}}
+{{- if $.Options.MaxCalls}}
+// Only the most recent {{$.Options.MaxCalls}} calls are kept, oldest first.
+{{- end}}
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.CallsName true}}() []{{.ParamsStruct}} {
+	{{- if $.Options.Concurrent}}
+	{{.Receiver}}.{{$interface.MutexName}}.Lock()
+	defer {{.Receiver}}.{{$interface.MutexName}}.Unlock()
+	{{- end}}
+	{{- if $.Options.CallStore}}
+	if {{.Receiver}}.{{.CallStoreName}} == nil {
+		return nil
+	}
+	return {{.Receiver}}.{{.CallStoreName}}.Calls()
+	{{- else if $.Options.MaxCalls}}
+	if len({{.Receiver}}.{{.CallsName false}}) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	{{- $start := $.Local "start"}}{{$calls := $.Local "calls"}}
+	{{$start}} := {{.Receiver}}.{{.CallTotalName}} % len({{.Receiver}}.{{.CallsName false}})
+	{{$calls}} := make([]{{.ParamsStruct}}, 0, len({{.Receiver}}.{{.CallsName false}}))
+	{{$calls}} = append({{$calls}}, {{.Receiver}}.{{.CallsName false}}[{{$start}}:]...)
+	return append({{$calls}}, {{.Receiver}}.{{.CallsName false}}[:{{$start}}]...)
+	{{- else}}
+	return {{.Receiver}}.{{.CallsName false}}
+	{{- end}}
+}
+
+// {{.CallCountName}} returns the number of calls made to {{.Name}}.
+{{- if $.Options.MaxCalls}}
+// It includes the calls that are no longer kept.
+{{- end}}
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.CallCountName}}() int {
+	{{- if $.Options.Concurrent}}
+	{{.Receiver}}.{{$interface.MutexName}}.Lock()
+	defer {{.Receiver}}.{{$interface.MutexName}}.Unlock()
+	{{- end}}
+	{{- if $.Options.CallStore}}
+	if {{.Receiver}}.{{.CallStoreName}} == nil {
+		return 0
+	}
+	return {{.Receiver}}.{{.CallStoreName}}.Len()
+	{{- else if $.Options.MaxCalls}}
+	return {{.Receiver}}.{{.CallTotalName}}
+	{{- else}}
+	return len({{.Receiver}}.{{.CallsName false}})
+	{{- end}}
+}
+
+// {{.CalledName}} reports whether {{.Name}} has been called.
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.CalledName}}() bool {
+	return {{.Receiver}}.{{.CallCountName}}() > 0
+}
+
+// {{.LastCallName}} returns the parameters of the most recent call to {{.Name}},
+// and whether there has been one.
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.LastCallName}}() ({{.ParamsStruct}}, bool) {
+	{{- $calls := $.Local "calls"}}
+	{{$calls}} := {{.Receiver}}.{{.CallsName true}}()
+	if len({{$calls}}) == 0 {
+		return {{.ParamsStruct}}{}, false
+	}
+	return {{$calls}}[len({{$calls}})-1], true
+}
+{{- end}}
+{{- if .CanCountByArg}}
+
+// {{.CountByArgName}} returns the number of calls made to {{.Name}} for each
+// distinct {{if .CountKeyIsArg}}argument{{else}}set of arguments, formatted as a string{{end}}.
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.CountByArgName}}() map[{{.CountKeyType}}]int {
+	{{- $counts := $.Local "counts"}}{{$call := $.Local "call"}}
+	{{$counts}} := make(map[{{.CountKeyType}}]int)
+	for _, {{$call}} := range {{.Receiver}}.{{.CallsName true}}() {
+		{{$counts}}[{{.CountKey $call}}]++
+	}
+	return {{$counts}}
+}
+{{- end}}
+{{- if .CanMatch}}
+
+// {{.FirstMatchingName}} returns the first recorded call to {{.Name}} for which
+// pred returns true, and false if there isn't one.
+{{- $pred := $.Local "pred"}}{{$call := $.Local "call"}}
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.FirstMatchingName}}({{$pred}} func({{.ParamsStruct}}) bool) ({{.ParamsStruct}}, bool) {
+	for _, {{$call}} := range {{.Receiver}}.{{.CallsName true}}() {
+		if {{$pred}}({{$call}}) {
+			return {{$call}}, true
+		}
+	}
+	return {{.ParamsStruct}}{}, false
+}
+{{- end}}
+{{end}}
+{{- if .HasRequired}}{{$r := $.Options.ReceiverName}}
+// {{.ValidateName}} returns an error if any stub of {{$r}} that is tagged as required is
+// nil.
+{{- $v := $.Local "v"}}{{$i := $.Local "i"}}{{$field := $.Local "field"}}
+func ({{$r}} *{{.TypeName}}) {{.ValidateName}}() error {
+	{{$v}} := reflect.ValueOf({{$r}}).Elem()
+	for {{$i}} := 0; {{$i}} < {{$v}}.NumField(); {{$i}}++ {
+		{{$field}} := {{$v}}.Type().Field({{$i}})
+		if {{$field}}.Tag.Get("stubber") == "required" && {{$v}}.Field({{$i}}).IsNil() {
+			return fmt.Errorf("{{.ImplName}}: required stub %s is nil", {{$field}}.Name)
+		}
+	}
+	return nil
+}
+{{end}}
+{{- if $.Options.AssertUsed}}{{$r := $.Options.ReceiverName}}
+// {{.AssertUsedName}} registers a cleanup with tb that reports an error for
+// each stub of {{$r}} that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+{{- $tb := $.Local "tb"}}
+func ({{$r}} *{{.TypeName}}) {{.AssertUsedName}}({{$tb}} testing.TB) {
+	{{$tb}}.Cleanup(func() {
+		{{- range .Funcs}}
+		if {{.Receiver}}.{{.StubName}} != nil && {{.Uncalled}} {
+			{{$tb}}.Errorf("{{$interface.ImplName}}: {{.StubName}} was set, but {{.Name}} was never called")
+		}
+		{{- end}}
+	})
+}
+{{end}}
+{{- if $.Options.Verify}}{{$r := $.Options.ReceiverName}}
+// {{.VerifyName}} reports an error to tb for each stub of {{$r}} that was set but
+// hasn't been called yet.
+{{- $tb := $.Local "tb"}}
+func ({{$r}} *{{.TypeName}}) {{.VerifyName}}({{$tb}} testing.TB) {
+	{{$tb}}.Helper()
+	{{- range .Funcs}}
+	if {{.Receiver}}.{{.StubName}} != nil && {{.Receiver}}.{{.CallCountName}}() == 0 {
+		{{$tb}}.Errorf("{{$interface.ImplName}}: {{.StubName}} was set, but {{.Name}} was never called")
+	}
+	{{- end}}
+}
+{{end}}
+{{- if $.Options.Marshal}}{{$r := $.Options.ReceiverName}}
+// {{.MarshalTextName}} encodes the calls made to {{$r}} as text, with a line for each
+// call holding the method name and its parameters as JSON. Calls are grouped
+// by method so that the result is stable enough for snapshot tests.
+{{- $buf := $.Local "buf"}}{{$call := $.Local "call"}}{{$args := $.Local "args"}}{{$err := $.Local "err"}}
+func ({{$r}} *{{.TypeName}}) {{.MarshalTextName}}() ([]byte, error) {
+	var {{$buf}} bytes.Buffer
+	{{- range .Funcs}}
+	for _, {{$call}} := range {{$r}}.{{.CallsName true}}() {
+		{{$args}}, {{$err}} := json.Marshal({{$call}})
+		if {{$err}} != nil {
+			return nil, {{$err}}
+		}
+		{{$buf}}.WriteString("{{.Name}} ")
+		{{$buf}}.Write({{$args}})
+		{{$buf}}.WriteByte('\n')
+	}
+	{{- end}}
+	return {{$buf}}.Bytes(), nil
 }
 {{end}}
+{{- if $.Options.CallLog}}{{$r := $.Options.ReceiverName}}
+// {{.CallLogName}} returns the names of the methods called on {{$r}}, in the order in
+// which they were called.
+func ({{$r}} *{{.TypeName}}) {{.CallLogName}}() []string {
+	{{- if $.Options.Concurrent}}
+	{{$r}}.{{.MutexName}}.Lock()
+	defer {{$r}}.{{.MutexName}}.Unlock()
+	{{- end}}
+	return {{$r}}.{{.LogName}}
+}
 
-// Compile-time check that the implementation matches the interface.
-var _ {{.QualName}} = (*{{.ImplName}})(nil)
+// {{.CallOrderName}} reports an error to tb unless the methods called on {{$r}}
+// were exactly methods, in that order.
+{{- $tb := $.Local "tb"}}{{$methods := $.Local "methods"}}{{$calls := $.Local "calls"}}{{$i := $.Local "i"}}{{$method := $.Local "method"}}
+func ({{$r}} *{{.TypeName}}) {{.CallOrderName}}({{$tb}} testing.TB, {{$methods}} ...string) {
+	{{$tb}}.Helper()
+	{{$calls}} := {{$r}}.{{.CallLogName}}()
+	if len({{$calls}}) != len({{$methods}}) {
+		{{$tb}}.Errorf("{{.ImplName}}: got calls %q, want %q", {{$calls}}, {{$methods}})
+		return
+	}
+	for {{$i}} := range {{$methods}} {
+		if {{$calls}}[{{$i}}] != {{$methods}}[{{$i}}] {
+			{{$tb}}.Errorf("{{.ImplName}}: got calls %q, want %q", {{$calls}}, {{$methods}})
+			return
+		}
+	}
+}
+
+// {{.CallSubsequenceName}} reports an error to tb unless methods were called on
+// {{$r}} in that order, possibly interleaved with other calls.
+func ({{$r}} *{{.TypeName}}) {{.CallSubsequenceName}}({{$tb}} testing.TB, {{$methods}} ...string) {
+	{{$tb}}.Helper()
+	{{$calls}} := {{$r}}.{{.CallLogName}}()
+	{{$i}} := 0
+	for _, {{$method}} := range {{$calls}} {
+		if {{$i}} < len({{$methods}}) && {{$method}} == {{$methods}}[{{$i}}] {
+			{{$i}}++
+		}
+	}
+	if {{$i}} < len({{$methods}}) {
+		{{$tb}}.Errorf("{{.ImplName}}: got calls %q, want them to include %q in order", {{$calls}}, {{$methods}})
+	}
+}
+{{end}}
+{{- if not $.Options.ValueReceiver}}
+
+// {{.ResetName}} clears the calls recorded by {{$.Options.ReceiverName}}, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func ({{$.Options.ReceiverName}} *{{.TypeName}}) {{.ResetName}}() {
+	{{- if $.Options.Concurrent}}
+	{{$.Options.ReceiverName}}.{{.MutexName}}.Lock()
+	defer {{$.Options.ReceiverName}}.{{.MutexName}}.Unlock()
+	{{- end}}
+	{{- range .Funcs}}
+	{{- if $.Options.CallStore}}
+	if {{.Receiver}}.{{.CallStoreName}} != nil {
+		{{.Receiver}}.{{.CallStoreName}}.Reset()
+	}
+	{{- else}}
+	{{.Receiver}}.{{.CallsName false}} = nil
+	{{- if $.Options.MaxCalls}}
+	{{.Receiver}}.{{.CallTotalName}} = 0
+	{{- end}}
+	{{- end}}
+	{{- if $.Options.RecoverPanics}}
+	{{.Receiver}}.{{.PanicsName}} = nil
+	{{- end}}
+	{{- end}}
+	{{- if $.Options.CallLog}}
+	{{$.Options.ReceiverName}}.{{.LogName}} = nil
+	{{- end}}
+}
+{{- end}}
+{{- if $.Options.Clone}}
+// {{.CloneName}} returns a new {{.ImplName}} with the same stubs as {{$.Options.ReceiverName}}, but
+// without any recorded calls.
+func ({{$.Options.ReceiverName}} *{{.TypeName}}) {{.CloneName}}() *{{.TypeName}} {
+	return &{{.TypeName}}{
+		{{- if eq $.Options.Unexpected "fail"}}
+		{{.TBName}}: {{$.Options.ReceiverName}}.{{.TBName}},
+		{{- end}}
+		{{- if $.Options.Sink}}
+		{{.SinkName}}: {{$.Options.ReceiverName}}.{{.SinkName}},
+		{{- end}}
+		{{- if $.Options.Spy}}
+		{{.RealName}}: {{$.Options.ReceiverName}}.{{.RealName}},
+		{{- end}}
+		{{- range .Funcs}}
+		{{.StubName}}: {{.Receiver}}.{{.StubName}},
+		{{- if $.Options.PanicToggles}}
+		{{.AllowNilName}}: {{.Receiver}}.{{.AllowNilName}},
+		{{- end}}
+		{{- if .CanFail}}
+		{{.FailAfterName}}: {{.Receiver}}.{{.FailAfterName}},
+		{{.FailErrorName}}: {{.Receiver}}.{{.FailErrorName}},
+		{{- end}}
+		{{- if .CanValidate}}
+		{{.ValidateName}}: {{.Receiver}}.{{.ValidateName}},
+		{{- end}}
+		{{- if .CanReturn}}
+		{{.ReturnsName}}: {{.Receiver}}.{{.ReturnsName}},
+		{{- end}}
+		{{- end}}
+	}
+}
+{{end}}
+{{- if $.Options.Gomock}}{{$r := $.Options.ReceiverName}}
+// {{.ExpectName}} returns a recorder for setting the stubs of {{$r}} from expected
+// calls, in the style of gomock. A call that doesn't match one of its
+// method's expected calls panics.
+func ({{$r}} *{{.TypeName}}) {{.ExpectName}}() *{{.RecorderName}}{{.TypeArgs}} {
+	if {{$r}}.{{.RecorderFieldName}} == nil {
+		{{$r}}.{{.RecorderFieldName}} = &{{.RecorderName}}{{.TypeArgs}}{stub: {{$r}}}
+	}
+	return {{$r}}.{{.RecorderFieldName}}
+}
+
+// {{.RecorderName}} sets the stubs of its {{.ImplName}} from expected calls.
+type {{.RecorderName}}{{.TypeParamsDecl}} struct {
+	stub *{{.TypeName}}
+	{{- range .Funcs}}
+	{{.ExpectedName}} support.Expectations[{{.ResultsStruct}}]
+	{{- end}}
+}
+{{range .Funcs}}
+// {{.Name}} expects a call to {{.Name}} with arguments matching the given ones,
+// which are either values or support.Matchers, and sets {{.StubName}}.
+func ({{.Receiver}} *{{$interface.RecorderName}}{{$interface.TypeArgs}}) {{.Name}}({{.ExpectParams}}) *{{.CallTypeName}}{{$interface.TypeArgs}} {
+	{{.Receiver}}.stub.{{.StubName}} = func{{.ParamsString}} {{.ResultsString}} {
+		{{if .HasResults}}{{.ResultsVarName}} := {{end}}{{.Receiver}}.{{.ExpectedName}}.Call("{{$interface.ImplName}}.{{.Name}}", {{.ExpectArgs true}})
+		{{- if .HasResults}}
+		return {{.StructResults .ResultsVarName}}
+		{{- end}}
+	}
+	return &{{.CallTypeName}}{{$interface.TypeArgs}}{ {{.Receiver}}.{{.ExpectedName}}.Expect({{.ExpectArgs false}}) }
+}
+
+// {{.CallTypeName}} is an expected call to {{$interface.ImplName}}.{{.Name}}.
+type {{.CallTypeName}}{{$interface.TypeParamsDecl}} struct {
+	expectation *support.Expectation[{{.ResultsStruct}}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func ({{.Receiver}} *{{.CallTypeName}}{{$interface.TypeArgs}}) Return{{.ReturnParams}} *{{.CallTypeName}}{{$interface.TypeArgs}} {
+	{{.Receiver}}.expectation.Return({{.ResultsStruct}}{ {{.ReturnParamNames}} })
+	return {{.Receiver}}
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+{{- $n := $.Local "n"}}
+func ({{.Receiver}} *{{.CallTypeName}}{{$interface.TypeArgs}}) Times({{$n}} int) *{{.CallTypeName}}{{$interface.TypeArgs}} {
+	{{.Receiver}}.expectation.Times({{$n}})
+	return {{.Receiver}}
+}
+
+// AnyTimes expects the call any number of times.
+func ({{.Receiver}} *{{.CallTypeName}}{{$interface.TypeArgs}}) AnyTimes() *{{.CallTypeName}}{{$interface.TypeArgs}} {
+	{{.Receiver}}.expectation.AnyTimes()
+	return {{.Receiver}}
+}
+{{end}}
+{{- end}}
+{{- if $.Options.AccessorIface}}
+// {{.AccessorName}} is the set of methods through which a {{.ImplName}}'s
+// recorded calls are accessed.
+type {{.AccessorName}}{{.TypeParamsDecl}} interface {
+	{{- range .Funcs}}
+	{{.CallsName true}}() []{{.ParamsStruct}}
+	{{.CallCountName}}() int
+	{{.CalledName}}() bool
+	{{.LastCallName}}() ({{.ParamsStruct}}, bool)
+	{{- if .CanCountByArg}}
+	{{.CountByArgName}}() map[{{.CountKeyType}}]int
+	{{- end}}
+	{{- if .CanMatch}}
+	{{.FirstMatchingName}}(pred func({{.ParamsStruct}}) bool) ({{.ParamsStruct}}, bool)
+	{{- end}}
+	{{- end}}
+	{{- if $.Options.CallLog}}
+	{{.CallLogName}}() []string
+	{{.CallOrderName}}(tb testing.TB, methods ...string)
+	{{.CallSubsequenceName}}(tb testing.TB, methods ...string)
+	{{- end}}
+	{{- if $.Options.AssertUsed}}
+	{{.AssertUsedName}}(tb testing.TB)
+	{{- end}}
+	{{- if $.Options.Verify}}
+	{{.VerifyName}}(tb testing.TB)
+	{{- end}}
+	{{.ResetName}}()
+	{{- if $.Options.Clone}}
+	{{.CloneName}}() *{{.TypeName}}
+	{{- end}}
+}
 {{end}}
+{{if or (not .Partial) $.Options.AccessorIface}}
+// Compile-time check that the implementation matches the interface.
+{{if .TypeParams -}}
+func _{{.TypeParamsDecl}}() {
+	{{- if not .Partial}}
+	var _ {{.InterfaceName}}{{.TypeArgs}} = {{if $.Options.ValueReceiver}}{{.TypeName}}{}{{else}}(*{{.TypeName}})(nil){{end}}
+	{{- end}}
+	{{- if $.Options.AccessorIface}}
+	var _ {{.AccessorName}}{{.TypeArgs}} = (*{{.TypeName}})(nil)
+	{{- end}}
+}
+{{- else -}}
+{{- if not .Partial -}}
+var _ {{.InterfaceName}} = {{if $.Options.ValueReceiver}}{{.ImplName}}{}{{else}}(*{{.ImplName}})(nil){{end}}
+{{- end}}
+{{- if .Concrete}}
+var _ {{.InterfaceName}} = (*{{.SourceName}})(nil)
+{{- end}}
+{{- if $.Options.AccessorIface}}
+var _ {{.AccessorName}} = (*{{.ImplName}})(nil)
+{{- end}}
+{{- end}}
+{{- end}}
+{{if $.Options.Regions}}
+//endregion
+{{end}}{{end}}
+
+{{- define "nilstub" -}}
+if {{.Receiver}}.{{.StubName}} == nil{{if .Interface.Pkg.Options.Spy}} && {{.Receiver}}.{{.Interface.RealName}} == nil{{end}}{{if .CanReturn}} && {{.NextName}} == nil{{end}} {
+	{{- if .Interface.Pkg.Options.PanicToggles}}
+	if {{.Receiver}}.{{.AllowNilName}} {
+		return {{.ZeroResults}}
+	}
+	{{- end}}
+	{{template "unexpected" .}}
+}
+{{- end}}
+{{- define "unexpected"}}
+{{- if eq .Interface.Pkg.Options.Unexpected "fail" -}}
+if {{.Receiver}}.{{.Interface.TBName}} == nil {
+	panic({{.NilStubMessage}})
+}
+{{.Receiver}}.{{.Interface.TBName}}.Helper()
+{{.Receiver}}.{{.Interface.TBName}}.Errorf("unexpected call to {{.Interface.ImplName}}.{{.Name}}")
+return {{.ZeroResults}}
+{{- else if eq .Interface.Pkg.Options.Unexpected "warn" -}}
+fmt.Fprintln(os.Stderr, "warning: unexpected call to {{.Interface.ImplName}}.{{.Name}}")
+return {{.ZeroResults}}
+{{- else if eq .Interface.Pkg.Options.Unexpected "toggle" -}}
+if PanicOnNilStub {
+	panic({{.NilStubMessage}})
+}
+return {{.ZeroResults}}
+{{- else -}}
+panic({{.NilStubMessage}})
+{{- end}}
+{{- end}}
+`))
+
+	toggleTemplate = template.Must(template.New("").Parse(`{{.HeaderComment}}
+{{range .BuildLines}}
+{{.}}
+{{- end}}
+
+package {{.OutputName}}
+
+// PanicOnNilStub determines whether calling a method without a stub panics.
+// If it's false, such a method returns zero values instead, which may be
+// preferable outside of tests.
+var PanicOnNilStub = true
 `))
 )
 
+// testOnlyTag is the build tag that stubs generated with Options.TestOnly
+// require.
+const testOnlyTag = "test_mocks"
+
+// toggleFilename is the name of the file declaring PanicOnNilStub in each
+// output directory, which is shared by every stub in that directory.
+const toggleFilename = "panic_on_nil_stub.go"
+
 type arrayFlags []string
 
 func (i *arrayFlags) String() string {
@@ -141,11 +772,67 @@ func (i *arrayFlags) Set(value string) error {
 
 func main() {
 	var (
-		outputDir = flag.String("output", "", "path to output directory; '-' will write result to stdout")
+		outputDir = flag.String("output", "", "path to output directory, or the root of the output tree for inputs ending in /...; '-' will write result to stdout")
 		typeNames = flag.String("types", "", "comma-separated list of type names to stub")
+		typesRe   = flag.String("types-regexp", "", "regular expression matching the names of types to stub, in addition to -types")
+		exclude   = flag.String("exclude", "", "comma-separated list of type names not to stub, even if selected by -types or -types-regexp")
+		pkgPath   = flag.String("pkgpath", "", "import path of the package to stub when loading an input yields several")
+		modMocks  = flag.Bool("module-mocks", false, "stub the exported interfaces of every package in the current module into internal/mocks")
+		since     = flag.String("since", "", "only stub packages with Go files that changed since this git ref")
+		initDirs  = flag.Bool("init", false, "add a go:generate directive for stubber to each file declaring interfaces, then exit")
+		scaffold  = flag.String("scaffold", "", "path to a JSON file of recorded calls from which to scaffold a test")
+		recvName  = flag.String("receivername", "s", "name of the receiver variable in generated methods")
+		stubSufx  = flag.String("stub-suffix", "Stub", "suffix added to each method's name to name the field holding its stub")
+		prefix    = flag.String("prefix", "", "prefix added to the name of each stub type, e.g. Fake")
+		suffix    = flag.String("suffix", "", "suffix added to the name of each stub type, e.g. Mock")
+		failAfter = flag.Bool("failafter", false, "generate fields to make error-returning methods fail after a number of calls")
+		regions   = flag.Bool("regions", false, "surround each stub with foldable region markers")
+		namedFns  = flag.Bool("namedfuncs", false, "declare a named func type for each stub field")
+		validate  = flag.Bool("validate", false, "generate fields to validate the arguments of each call")
+		sink      = flag.Bool("sink", false, "generate a Sink field that is sent an event for every call")
+		callLog   = flag.Bool("calllog", false, "record the order of calls to each stub's methods and generate assertions on it")
+		accessor  = flag.Bool("accessoriface", false, "declare an interface of the methods that access each stub's recorded calls")
+		callStore = flag.Bool("callstore", false, "store each method's calls in a support.CallStore that can be replaced")
+		marshal   = flag.Bool("marshal", false, "generate a MarshalText method that encodes each stub's recorded calls")
+		strict    = flag.Bool("strictrecord", false, "fail if any method has a parameter containing a chan, func or map")
+		toggles   = flag.Bool("panictoggles", false, "generate a field per method, e.g. BalanceAllowNil, that makes it return zero values when it has no stub")
+		recoverPs = flag.Bool("recoverpanics", false, "record panics raised by each stub func before re-raising them")
+		spy       = flag.Bool("spy", false, "generate a Real field that methods without a stub delegate to")
+		delegate  = flag.Bool("delegate", false, "like -spy, but name the field that methods without a stub delegate to Delegate")
+		matchers  = flag.Bool("matchers", false, "generate helpers that find recorded calls matching a predicate")
+		used      = flag.Bool("assertused", false, "generate a method that fails a test if a stub that was set is never called")
+		verify    = flag.Bool("verify", false, "generate a Verify method that fails a test if a stub that was set hasn't been called")
+		countArgs = flag.Bool("countbyarg", false, "generate helpers that count each method's calls by their arguments")
+		testOnly  = flag.Bool("testonly", false, "only build stubs with the test_mocks tag, keeping them out of release builds")
+		required  = flag.String("required", "", "comma-separated list of methods, e.g. Account.Balance, whose stubs a Validate method requires")
+		methodsOf = flag.String("methods-of", "", "comma-separated list of concrete types whose method sets to stub, e.g. Client")
+		examples  = flag.Bool("examples", false, "add an example of setting a stub to each stub's doc comment")
+		parallel  = flag.Bool("concurrent", false, "guard each stub's recorded calls with a mutex so it can be called from several goroutines")
+		gomock    = flag.Bool("gomock", false, "generate an EXPECT method on each stub that sets its stubs from expected calls, in the style of gomock")
+		returns   = flag.Bool("returns", false, "generate a field per method holding a queue of results to return while it has no stub")
+		maxCalls  = flag.Int("max-calls", 0, "if positive, only keep the most recent calls to each method, up to this many, in a fixed-size buffer")
+		recordRet = flag.Bool("record-returns", false, "record the results of each call alongside its parameters")
+		valueRecv = flag.Bool("value-receiver", false, "implement methods with value receivers, without recording calls")
+		clone     = flag.Bool("clone", false, "generate a Clone method that copies a stub without its recorded calls")
+		verbose   = flag.Bool("verbose-panic", false, "include the arguments of a call in the panic of a method without a stub")
+		useAny    = flag.Bool("useany", false, "render empty interfaces as any")
+		unexpect  = flag.String("unexpected", UnexpectedPanic, "what to do when a method without a stub is called: panic, fail, warn or toggle")
+		zero      = flag.Bool("zero", false, "make methods without a stub return zero values instead of panicking; shorthand for -zerovalue=noop")
+		filename  = flag.String("filename", DefaultFilename, "template for the name of each package's stubs file, where {{.Name}} is the package name")
+		single    = flag.Bool("single-file", false, "write the stubs for all input packages into one file")
+		tests     = flag.Bool("tests", false, "also stub interfaces declared in the input packages' own test files")
+		manifest  = flag.String("manifest", "", "path of a JSON file to write listing each generated stub and its methods")
+		dryRun    = flag.Bool("dry-run", false, "log the files that would be written without writing them")
+		comment   = flag.String("comment", DefaultComment, "text of the comment at the top of each generated file")
+		buildTag  = flag.String("build-tag", DefaultBuildTag, "build constraint of each generated file, in //go:build syntax; 'none' omits it")
+		fileMode  = flag.String("file-mode", fmt.Sprintf("%#o", DefaultFileMode), "octal permission bits of the files written, e.g. 0664")
+		style     = flag.String("style", DefaultStyle, "style of the generated stubs: "+strings.Join(Styles(), ", "))
+		testify   = flag.Bool("testify", false, "generate mocks for github.com/stretchr/testify/mock; shorthand for -style="+TestifyStyle)
+		zeroValue = flag.String("zerovalue", "", "set to noop to make a stub's zero value usable, recording calls and returning zero values")
 	)
-	var renameFlags arrayFlags
+	var renameFlags, outputMapFlags arrayFlags
 	flag.Var(&renameFlags, "rename", "rename an interface to something else in the output")
+	flag.Var(&outputMapFlags, "output-map", "write an interface's stub to a different output directory, e.g. bank.Account=./mocks/account")
 
 	log.SetFlags(0)
 	log.SetPrefix("stubber: ")
@@ -155,13 +842,45 @@ func main() {
 	if *typeNames != "" {
 		types = strings.Split(*typeNames, ",")
 	}
+	var excluded []string
+	if *exclude != "" {
+		excluded = strings.Split(*exclude, ",")
+	}
+	var typesRegexp *regexp.Regexp
+	if *typesRe != "" {
+		var err error
+		if typesRegexp, err = regexp.Compile(*typesRe); err != nil {
+			log.Fatalf("invalid -types-regexp: %s", err)
+		}
+	}
 
-	// Default to the current directory, but grab the first argument as the dir if it's available.
+	// Default to the current directory, but grab the arguments as the dirs, or
+	// files within them, if they're available.
 	inputDirs := flag.Args()
 	if len(inputDirs) == 0 {
 		inputDirs = []string{"."}
 	}
 
+	if *initDirs {
+		Init(inputDirs)
+		return
+	}
+
+	if *modMocks {
+		var mocksDir string
+		inputDirs, mocksDir = ModuleMocks(inputDirs[0])
+		if *outputDir == "" {
+			*outputDir = mocksDir
+		}
+	}
+
+	if *zero {
+		*zeroValue = ZeroValueNoop
+	}
+	if *testify {
+		*style = TestifyStyle
+	}
+
 	var out io.Writer
 	if *outputDir == "-" {
 		out = os.Stdout
@@ -176,64 +895,632 @@ func main() {
 		renames[parts[0]] = parts[1]
 	}
 
-	Main(types, inputDirs, *outputDir, out, renames)
+	var requiredMethods []string
+	if *required != "" {
+		requiredMethods = strings.Split(*required, ",")
+	}
+	var methodsOfTypes []string
+	if *methodsOf != "" {
+		methodsOfTypes = strings.Split(*methodsOf, ",")
+	}
+
+	opts := Options{
+		OutputMap:     make(map[string]string),
+		PkgPath:       *pkgPath,
+		ReceiverName:  *recvName,
+		StubSuffix:    *stubSufx,
+		Prefix:        *prefix,
+		Suffix:        *suffix,
+		FailAfter:     *failAfter,
+		Regions:       *regions,
+		NamedFuncs:    *namedFns,
+		Validate:      *validate,
+		Sink:          *sink,
+		CallLog:       *callLog,
+		CallStore:     *callStore,
+		Marshal:       *marshal,
+		StrictRecord:  *strict,
+		PanicToggles:  *toggles,
+		RecoverPanics: *recoverPs,
+		Spy:           *spy,
+		Delegate:      *delegate,
+		Matchers:      *matchers,
+		AssertUsed:    *used,
+		Verify:        *verify,
+		CountByArg:    *countArgs,
+		TestOnly:      *testOnly,
+		Required:      requiredMethods,
+		MethodsOf:     methodsOfTypes,
+		Examples:      *examples,
+		Concurrent:    *parallel,
+		Gomock:        *gomock,
+		Returns:       *returns,
+		RecordReturns: *recordRet,
+		ValueReceiver: *valueRecv,
+		Clone:         *clone,
+		MaxCalls:      *maxCalls,
+		ExportedOnly:  *modMocks,
+		TypesRegexp:   typesRegexp,
+		Exclude:       excluded,
+		Since:         *since,
+		AccessorIface: *accessor,
+		VerbosePanic:  *verbose,
+		UseAny:        *useAny,
+		Unexpected:    *unexpect,
+		ZeroValue:     *zeroValue,
+		Style:         *style,
+		Filename:      *filename,
+		Comment:       *comment,
+		BuildTag:      *buildTag,
+		DryRun:        *dryRun,
+		Manifest:      *manifest,
+		Tests:         *tests,
+		SingleFile:    *single,
+	}
+	for _, om := range outputMapFlags {
+		parts := strings.SplitN(om, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("invalid output mapping: %s", om)
+		}
+		opts.OutputMap[parts[0]] = parts[1]
+	}
+	mode, err := strconv.ParseUint(*fileMode, 8, 32)
+	if err != nil {
+		log.Fatalf("invalid file mode %s: %s", *fileMode, err)
+	}
+	opts.FileMode = os.FileMode(mode)
+	if *scaffold != "" {
+		data, err := ioutil.ReadFile(*scaffold)
+		if err != nil {
+			log.Fatalf("cannot read recordings: %s", err)
+		}
+		if err := json.Unmarshal(data, &opts.Recordings); err != nil {
+			log.Fatalf("cannot parse recordings %s: %s", *scaffold, err)
+		}
+	}
+
+	Main(types, inputDirs, *outputDir, out, renames, opts)
+}
+
+// supportPath is the import path of the package providing types shared by
+// generated stubs.
+const supportPath = "github.com/dradtke/stubber/support"
+
+// Values of Options.Unexpected.
+const (
+	// UnexpectedPanic panics when a method without a stub is called.
+	UnexpectedPanic = "panic"
+	// UnexpectedFail reports a method without a stub as a test failure via
+	// the stub's TB field and returns zero values, so that a test can report
+	// every unexpected call at once.
+	UnexpectedFail = "fail"
+	// UnexpectedWarn prints a warning to standard error when a method without
+	// a stub is called and returns zero values, which helps to find the
+	// methods that a new stub needs.
+	UnexpectedWarn = "warn"
+	// UnexpectedToggle panics when a method without a stub is called if the
+	// generated package-level variable PanicOnNilStub is true, which it is by
+	// default, and otherwise returns zero values.
+	UnexpectedToggle = "toggle"
+)
+
+// Values of Options.ZeroValue.
+const (
+	// ZeroValueNoop makes a method without a stub record its call and return
+	// zero values, so that the zero value of a stub can be used as is.
+	ZeroValueNoop = "noop"
+)
+
+// Options holds optional settings that alter what Main generates.
+type Options struct {
+	// OutputMap maps qualified interface names, e.g. "bank.Account", to the
+	// output directory that their stubs are written to, overriding the
+	// directory passed to Main.
+	OutputMap map[string]string
+	// PkgPath, if set, is the import path of the package to stub when loading
+	// an input yields several, such as test variants.
+	PkgPath string
+	// ReceiverName is the name of the receiver in generated methods. It
+	// defaults to "s".
+	ReceiverName string
+	// StubSuffix is added to the name of each method to name the field
+	// holding its stub. It defaults to "Stub".
+	StubSuffix string
+	// Prefix and Suffix are added to the name of each interface to give the
+	// name of its stub, unless it's renamed.
+	Prefix, Suffix string
+	// FailAfter generates, for each method whose last result is an error,
+	// fields that make it start returning an error after a number of calls.
+	FailAfter bool
+	// Regions surrounds the code generated for each interface with
+	// "//region" and "//endregion" markers that editors can fold.
+	Regions bool
+	// NamedFuncs declares a named func type for each method, e.g.
+	// AccountBalanceFunc, and uses it as the type of the method's stub field.
+	NamedFuncs bool
+	// Validate generates a field for each method holding a func that checks
+	// the arguments of each call before the stub is called.
+	Validate bool
+	// Sink generates a Sink field on each stub which, if set, is sent a
+	// support.CallEvent for every call.
+	Sink bool
+	// CallLog records the name of every method called on a stub, in order,
+	// and generates methods to assert on that order.
+	CallLog bool
+	// CallStore stores the calls to each method in a support.CallStore field,
+	// e.g. BalanceCallStore, instead of a slice, so that a test can choose
+	// how many calls are kept.
+	CallStore bool
+	// Marshal generates a MarshalText method on each stub, implementing
+	// encoding.TextMarshaler, that encodes its recorded calls for snapshot
+	// tests.
+	Marshal bool
+	// StrictRecord makes Main fail, listing the offending methods, if any
+	// method has a parameter containing a chan, func or map, since calls
+	// recording such a parameter can't be meaningfully compared.
+	StrictRecord bool
+	// PanicToggles generates a bool field for each method, e.g.
+	// BalanceAllowNil, which makes it return zero values when it's called
+	// without a stub, regardless of Unexpected. The field is false by
+	// default, so that Unexpected still applies unless it's set. It's the
+	// inverse of a PanicOnNil field defaulting to true, which a struct
+	// literal can't express: the zero value of a bool is false.
+	PanicToggles bool
+	// RecoverPanics records the value of any panic raised by a method's stub
+	// in a field, e.g. BalancePanics, before raising it again, so that tests
+	// exercising recovery logic can observe that the panic happened.
+	RecoverPanics bool
+	// Spy generates a Real field on each stub, holding an implementation of
+	// the interface. Methods without a stub record the call and then delegate
+	// to Real, so a stub only needs to override the methods under test.
+	Spy bool
+	// Delegate is like Spy, but names the field Delegate, e.g. to wrap a real
+	// object and override only some of its methods. It implies Spy.
+	Delegate bool
+	// Matchers generates, for each method with parameters, a helper such as
+	// SetNicknameFirstMatching that returns the first recorded call for which
+	// a predicate returns true.
+	Matchers bool
+	// AssertUsed generates an AssertAllStubsUsed method on each stub, which
+	// registers a test cleanup that fails if any stub func that was set was
+	// never called.
+	AssertUsed bool
+	// Verify generates a Verify method on each stub, which immediately fails
+	// a test if any stub func that was set hasn't been called.
+	Verify bool
+	// CountByArg generates, for each method with parameters, a helper such as
+	// SetNicknameCallCountByArg that counts its calls by their arguments. A
+	// method with a single comparable parameter is counted by its value, and
+	// others by their arguments formatted as a string.
+	CountByArg bool
+	// TestOnly adds the test_mocks tag to the build constraint of generated
+	// files, so that stubs are only built by e.g. go test -tags test_mocks,
+	// and never end up in release binaries.
+	TestOnly bool
+	// Required lists methods, e.g. "Account.Balance" or "bank.Account.Balance",
+	// whose stub fields are tagged with stubber:"required". Each stub with a
+	// required method gets a Validate method that returns an error if any
+	// required stub is nil.
+	Required []string
+	// MethodsOf lists concrete types, e.g. "Client", whose method sets are
+	// stubbed as well as the interfaces. An interface declaring each type's
+	// exported methods is generated for its stub to implement. Each type
+	// must be declared by one of the input packages.
+	MethodsOf []string
+	// Examples adds an example to the doc comment of each stub, showing how
+	// to set the stub of the interface's first method.
+	Examples bool
+	// Concurrent adds a mutex to each stub that guards its recorded calls, so
+	// that its methods can be called from several goroutines at once.
+	Concurrent bool
+	// Gomock generates an EXPECT method on each stub returning a recorder, in
+	// the style of gomock, whose methods set the stubs from expected calls,
+	// e.g. s.EXPECT().Balance().Return(10). The stubs can still be set
+	// directly.
+	Gomock bool
+	// Returns generates a field for each method with results, e.g.
+	// BalanceReturns, holding a queue of results that successive calls return
+	// while the method has no stub, e.g. to fail once and then succeed.
+	Returns bool
+	// RecordReturns records the results of each call alongside its
+	// parameters, in fields named after the results, or R0, R1 and so on if
+	// they're unnamed. A call is recorded along with its results when the
+	// method returns, so the calls are in the order they returned, and a
+	// Sink sees the call's parameters without its results.
+	RecordReturns bool
+	// ValueReceiver gives the methods implementing each interface value
+	// receivers, so that the stub itself rather than a pointer to it
+	// satisfies the interface. Calls can't be recorded through a copy, so
+	// the stubs don't record them, and options that depend on recorded calls
+	// can't be used.
+	ValueReceiver bool
+	// MaxCalls, if positive, keeps only the most recent MaxCalls calls to
+	// each method in a ring buffer, so that recording calls stops allocating
+	// once it's full. The call counts still include the calls that were
+	// dropped.
+	MaxCalls int
+	// Clone generates a Clone method on each stub that returns a copy with the
+	// same stubs but no recorded calls, e.g. for use in parallel subtests.
+	Clone bool
+	// AccessorIface declares an interface for each stub, e.g.
+	// AccountAccessor, listing the stub's methods that access its recorded
+	// calls, so that test helpers can accept any stub with those methods.
+	AccessorIface bool
+	// VerbosePanic includes the arguments of a call in the panic raised by a
+	// method without a stub, e.g. "Account.Deposit(10): nil method stub".
+	// Arguments are formatted with %v, except for funcs and chans, whose
+	// values would only be addresses.
+	VerbosePanic bool
+	// UseAny renders empty interface types as any, which requires Go 1.18.
+	UseAny bool
+	// Unexpected determines what a method does when it's called without a
+	// stub: UnexpectedPanic (the default), UnexpectedFail, UnexpectedWarn or
+	// UnexpectedToggle.
+	Unexpected string
+	// ZeroValue, if set to ZeroValueNoop, makes the zero value of each stub
+	// usable without initialization: a method without a stub records its
+	// call and returns zero values. It replaces Unexpected, so the two can't
+	// be combined.
+	ZeroValue string
+	// Style is the name of the registered Generator that produces the stubs,
+	// DefaultStyle if it's empty.
+	Style string
+	// Filename is a template for the name of the file, within the output
+	// directory, that each package's stubs are written to. Its .Name is the
+	// name of the input package. It defaults to DefaultFilename.
+	Filename string
+	// Comment is the text of the comment at the top of each generated file,
+	// which may span several lines. It defaults to DefaultComment.
+	Comment string
+	// BuildTag is the build constraint of each generated file, as a
+	// //go:build expression, or NoBuildTag to leave the files unconstrained.
+	// It defaults to DefaultBuildTag, which keeps the stubs out of the
+	// packages loaded to find interfaces. TestOnly adds its own tag to it.
+	BuildTag string
+	// DryRun makes Main log the files it would write, and their sizes,
+	// without writing them or creating output directories. The stubs are
+	// still generated and formatted, so errors are reported as usual.
+	DryRun bool
+	// Manifest, if set, is the path of a JSON file that Main writes listing
+	// a ManifestEntry for each generated stub, whether the stubs themselves
+	// are written to files or to an io.Writer.
+	Manifest string
+	// FileMode is the mode of the files that Main writes. It defaults to
+	// DefaultFileMode.
+	FileMode os.FileMode
+	// Tests also finds the interfaces declared in the input packages' own
+	// test files. Their stubs can only refer to them from a test file in the
+	// same package, e.g. with a Filename of "{{.Name}}_stubs_test.go".
+	Tests bool
+	// SingleFile writes the stubs for all of the input packages into a single
+	// file, whose name is given by Filename with the output package's name as
+	// its .Name. Interfaces with the same name in different packages are
+	// disambiguated as usual.
+	SingleFile bool
+	// ExportedOnly skips unexported interfaces.
+	ExportedOnly bool
+	// TypesRegexp, if set, also stubs the interfaces whose names it matches
+	// when only some type names are given to stub. If no type names are
+	// given, only the interfaces it matches are stubbed.
+	TypesRegexp *regexp.Regexp
+	// Exclude lists the names of interfaces that aren't stubbed, even if
+	// they're selected by name or by TypesRegexp.
+	Exclude []string
+	// Since, if set, is a git ref, and only the input packages with Go files
+	// that differ from it are stubbed. Patterns such as "./..." are expanded
+	// first, so that each package they match is checked on its own.
+	Since string
+	// Recordings, if set, are used to scaffold a characterization test
+	// alongside the stubs of each package with a recorded interface.
+	Recordings []Recording
+	// Overlay maps absolute file paths to contents that are used in place of
+	// what is on disk, allowing stubs to be generated from unsaved sources.
+	Overlay map[string][]byte
 }
 
-func Main(types, inputDirs []string, outputDir string, out io.Writer, renames map[string]string) {
-	if outputDir != "" {
-		if err := os.MkdirAll(outputDir, 0655); err != nil {
-			log.Fatalf("cannot make output directory: %s", err)
+// excluded reports whether the interface named name is in Exclude.
+func (opts Options) excluded(name string) bool {
+	for _, typ := range opts.Exclude {
+		if typ == name {
+			return true
 		}
 	}
+	return false
+}
 
-	var pkgs []*Package
+// stubName returns the name of the stub for an interface named name, with
+// the prefix and suffix added. The first letter of name is capitalized after
+// a prefix, e.g. the prefix fake turns reader into fakeReader. A stub written
+// into its interface's package would collide with the interface if it had
+// the same name, so it gets InPackagePrefix unless there's another prefix or
+// suffix.
+func (opts Options) stubName(name string, inPackage bool) string {
+	prefix := opts.Prefix
+	if inPackage && prefix == "" && opts.Suffix == "" {
+		prefix = InPackagePrefix
+	}
+	if prefix != "" {
+		name = strings.ToUpper(name[:1]) + name[1:]
+	}
+	return prefix + name + opts.Suffix
+}
+
+func Main(types, inputDirs []string, outputDir string, out io.Writer, renames map[string]string, opts Options) {
+	if opts.ReceiverName == "" {
+		opts.ReceiverName = "s"
+	}
+	if !token.IsIdentifier(opts.ReceiverName) || opts.ReceiverName == "_" {
+		log.Fatalf("invalid receiver name: %s", opts.ReceiverName)
+	}
+	if opts.StubSuffix == "" {
+		opts.StubSuffix = "Stub"
+	}
+	if !token.IsIdentifier("X" + opts.StubSuffix) {
+		log.Fatalf("invalid stub suffix: %s", opts.StubSuffix)
+	}
+	if opts.ValueReceiver {
+		for _, o := range []struct {
+			name string
+			set  bool
+		}{
+			{"failafter", opts.FailAfter},
+			{"calllog", opts.CallLog},
+			{"accessoriface", opts.AccessorIface},
+			{"callstore", opts.CallStore},
+			{"marshal", opts.Marshal},
+			{"recoverpanics", opts.RecoverPanics},
+			{"matchers", opts.Matchers},
+			{"assertused", opts.AssertUsed},
+			{"verify", opts.Verify},
+			{"countbyarg", opts.CountByArg},
+			{"required", len(opts.Required) > 0},
+			{"concurrent", opts.Concurrent},
+			{"returns", opts.Returns},
+			{"record-returns", opts.RecordReturns},
+			{"max-calls", opts.MaxCalls > 0},
+			{"scaffold", len(opts.Recordings) > 0},
+		} {
+			if o.set {
+				log.Fatalf("value-receiver can't be combined with %s, since calls aren't recorded", o.name)
+			}
+		}
+	}
+	if len(opts.Recordings) > 0 && opts.Style != "" && opts.Style != DefaultStyle {
+		log.Fatalf("scaffold can't be combined with style %s, since the scaffolded tests set the fields of stubber's own stubs", opts.Style)
+	}
+	if opts.RecordReturns && opts.CallStore {
+		log.Fatalf("record-returns can't be combined with callstore, since a store may not keep the calls it records")
+	}
+	if opts.MaxCalls < 0 {
+		log.Fatalf("invalid max-calls: %d", opts.MaxCalls)
+	}
+	if opts.MaxCalls > 0 && opts.CallStore {
+		log.Fatalf("max-calls can't be combined with callstore, since the store decides which calls to keep")
+	}
+	if opts.MaxCalls > 0 && opts.RecordReturns {
+		log.Fatalf("max-calls can't be combined with record-returns, since a call with its results is only recorded once it returns")
+	}
+	if !token.IsIdentifier(opts.Prefix + "X" + opts.Suffix) {
+		log.Fatalf("invalid stub name prefix or suffix: %q, %q", opts.Prefix, opts.Suffix)
+	}
+	switch opts.Unexpected {
+	case "":
+		opts.Unexpected = UnexpectedPanic
+	case UnexpectedPanic, UnexpectedFail, UnexpectedWarn, UnexpectedToggle:
+	default:
+		log.Fatalf("invalid value for unexpected: %s", opts.Unexpected)
+	}
+	switch opts.ZeroValue {
+	case "":
+	case ZeroValueNoop:
+		if opts.Unexpected != UnexpectedPanic {
+			log.Fatalf("zerovalue %s can't be combined with unexpected %s", opts.ZeroValue, opts.Unexpected)
+		}
+	default:
+		log.Fatalf("invalid value for zerovalue: %s", opts.ZeroValue)
+	}
+	if opts.Delegate {
+		opts.Spy = true
+	}
+	if opts.Style == "" {
+		opts.Style = DefaultStyle
+	}
+	if opts.FileMode == 0 {
+		opts.FileMode = DefaultFileMode
+	}
+	if opts.FileMode&^os.ModePerm != 0 {
+		log.Fatalf("invalid file mode: %s", opts.FileMode)
+	}
+	gen, err := lookupGenerator(opts.Style)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var dirs, outputDirs []string
 	for _, inputDir := range inputDirs {
-		pkg := NewPackage(inputDir, outputDir)
+		if isRecursivePattern(inputDir) {
+			expanded, expandedOutputDirs := ExpandPattern(inputDir, outputDir, opts)
+			dirs = append(dirs, expanded...)
+			outputDirs = append(outputDirs, expandedOutputDirs...)
+		} else {
+			dirs = append(dirs, inputDir)
+			outputDirs = append(outputDirs, outputDir)
+		}
+	}
+	changed := make(map[string]bool)
+	if opts.Since != "" {
+		for _, dir := range ChangedSince(opts.Since, dirs) {
+			changed[dir] = true
+		}
+		if len(changed) == 0 {
+			log.Printf("no packages changed since %s", opts.Since)
+			return
+		}
+	}
+
+	var pkgs []*Package
+	for i, dir := range dirs {
+		if opts.Since != "" && !changed[dir] {
+			continue
+		}
+		pkg := NewPackage(dir, outputDirs[i], opts)
 		pkg.Check(types)
 		pkgs = append(pkgs, pkg)
 		log.Printf("found package: %s", pkg.InputName)
 	}
+	// A type listed by -methods-of only has to be declared by one of the
+	// packages. With -since, that may be one that was skipped.
+	if opts.Since == "" {
+		for _, name := range opts.MethodsOf {
+			if !declaresType(pkgs, name) {
+				log.Fatalf("unknown type %s in methods-of", name)
+			}
+		}
+	}
+
+	if opts.StrictRecord {
+		var problems []string
+		for _, pkg := range pkgs {
+			for _, iface := range pkg.Interfaces {
+				for _, f := range iface.Funcs {
+					params := f.Signature.Params()
+					for i := 0; i < params.Len(); i++ {
+						if kind := unrecordable(params.At(i).Type()); kind != "" {
+							problems = append(problems, fmt.Sprintf("%s.%s: parameter %s contains a %s", iface.QualName, f.Name, f.sourceParamName(i), kind))
+						}
+					}
+				}
+			}
+		}
+		if len(problems) > 0 {
+			log.Fatalf("cannot record calls comparably:\n\t%s", strings.Join(problems, "\n\t"))
+		}
+	}
+
+	// Packages of the same name, e.g. a/client and b/client, would have their
+	// stubs written to the same file, so name those after their import paths.
+	type pkgName struct{ outputDir, name string }
+	type pkgDir struct{ outputDir, path string }
+	paths := make(map[pkgName][]string)
+	seen := make(map[pkgDir]bool)
+	for _, pkg := range pkgs {
+		if d := (pkgDir{pkg.OutputDir, pkg.Pkg.PkgPath}); !seen[d] {
+			seen[d] = true
+			key := pkgName{pkg.OutputDir, pkg.Pkg.Name}
+			paths[key] = append(paths[key], pkg.Pkg.PkgPath)
+		}
+	}
+	fileNames := make(map[pkgDir]string)
+	for key, pkgPaths := range paths {
+		if len(pkgPaths) <= 1 {
+			continue
+		}
+		sort.Strings(pkgPaths)
+		taken := make(map[string]struct{})
+		for _, pkgPath := range pkgPaths {
+			name := importAlias(pkgPath, key.name, taken)
+			taken[name] = struct{}{}
+			fileNames[pkgDir{key.outputDir, pkgPath}] = name
+		}
+	}
+	for _, pkg := range pkgs {
+		pkg.FileName = fileNames[pkgDir{pkg.OutputDir, pkg.Pkg.PkgPath}]
+	}
 
-	// Check for explicit renames.
+	// Check for explicit renames, which replace the whole name.
 	for _, pkg := range pkgs {
 		for _, iface := range pkg.Interfaces {
-			qualName := pkg.Pkg.Name + "." + iface.StubName
+			qualName := pkg.Pkg.Name + "." + iface.Name
 			if newName := renames[qualName]; newName != "" {
 				iface.StubName = newName
+			} else {
+				iface.StubName = opts.stubName(iface.Name, pkg.stubInPackage(iface))
 			}
 		}
 	}
 
-	// Check for duplicate interface names, e.g. "Client"
-	defs := make(map[string]int)
+	// Check for duplicate interface names, e.g. "Client", among the stubs
+	// written to the same output directory.
+	type def struct{ outputDir, name string }
+	defs := make(map[def]int)
 	for _, pkg := range pkgs {
 		for _, iface := range pkg.Interfaces {
-			defs[iface.StubName] += 1
+			defs[def{pkg.OutputDir, iface.StubName}] += 1
 		}
 	}
-	for name, count := range defs {
+	for d, count := range defs {
 		if count <= 1 {
 			continue
 		}
 		for _, pkg := range pkgs {
 			for _, iface := range pkg.Interfaces {
-				if iface.StubName == name {
-					iface.StubName = publicize(pkg.Pkg.Name) + iface.StubName
+				if pkg.OutputDir != d.outputDir || iface.StubName != d.name {
+					continue
+				}
+				if renames[pkg.Pkg.Name+"."+iface.Name] != "" {
+					iface.StubName = publicize(pkg.fileName()) + iface.StubName
+				} else {
+					iface.StubName = opts.stubName(publicize(pkg.fileName())+iface.Name, pkg.stubInPackage(iface))
 				}
 			}
 		}
 	}
 
-	var buf bytes.Buffer
+	if opts.SingleFile && len(pkgs) > 0 {
+		pkgs = []*Package{Merge(pkgs)}
+	}
+
+	// Move interfaces with their own output directory into separate packages.
+	var outputs []*Package
 	for _, pkg := range pkgs {
-		buf.Reset()
-		if err := t.Execute(&buf, pkg); err != nil {
+		outputs = append(outputs, pkg.Split(opts.OutputMap)...)
+	}
+
+	// The receiver would shadow a package of the same name in every method.
+	for _, pkg := range outputs {
+		if _, ok := pkg.DependencyNames[opts.ReceiverName]; ok {
+			log.Fatalf("receiver name %s collides with a package imported by the stubs in %s", opts.ReceiverName, pkg.OutputDir)
+		}
+	}
+
+	// Check that no two packages' stubs are written to the same file before
+	// writing any of them.
+	if out == nil {
+		written := make(map[string]string)
+		for _, pkg := range outputs {
+			if len(pkg.Interfaces) == 0 {
+				continue
+			}
+			newFilename := pkg.Filename()
+			if other, ok := written[newFilename]; ok && other != pkg.Pkg.PkgPath {
+				log.Fatalf("stubs for %s and %s would both be written to %s", other, pkg.Pkg.PkgPath, newFilename)
+			}
+			written[newFilename] = pkg.Pkg.PkgPath
+		}
+	}
+
+	var buf bytes.Buffer
+	toggles := make(map[string]bool)
+	for _, pkg := range outputs {
+		if len(pkg.Interfaces) == 0 {
+			log.Printf("no interfaces to stub in %s", pkg.OutputDir)
+			continue
+		}
+		if out == nil {
+			pkg.checkTestFiles()
+		}
+		if pkg.OutputDir != "" && !opts.DryRun {
+			ensureOutputDir(pkg.OutputDir)
+		}
+
+		src, err := gen.Generate(pkg)
+		if err != nil {
 			log.Fatal(err)
 		}
 
-		code, err := format.Source(buf.Bytes())
+		code, err := format.Source(src)
 		if err != nil {
-			log.Println(buf.String())
+			log.Print(sourceContext(src, err))
 			log.Fatalf("error formatting stubs: %s", err)
 		}
 
@@ -242,55 +1529,621 @@ func Main(types, inputDirs []string, outputDir string, out io.Writer, renames ma
 				log.Fatalf("failed to write result: %s", err)
 			}
 		} else {
-			newFilename := filepath.Join(outputDir, pkg.Pkg.Name+"_stubs.go")
-			log.Printf("writing %s", newFilename)
-			if err := ioutil.WriteFile(newFilename, code, 0644); err != nil {
-				log.Fatalf("failed to write output file %s: %s", newFilename, err)
-			}
+			writeFile(pkg.Filename(), code, opts)
 		}
-	}
-}
 
-type Package struct {
-	// OutputName is the name of the output package.
-	OutputName string
-	// InputName is the name of the input package.
-	InputName       string
-	Pkg             *packages.Package
-	Interfaces      []*Interface
-	Dependencies    map[string]struct{}
-	DependencyNames map[string]struct{}
-}
+		if opts.Unexpected == UnexpectedToggle && !toggles[pkg.OutputDir] {
+			toggles[pkg.OutputDir] = true
+			buf.Reset()
+			if err := toggleTemplate.Execute(&buf, pkg); err != nil {
+				log.Fatal(err)
+			}
+			code, err := format.Source(buf.Bytes())
+			if err != nil {
+				log.Fatalf("error formatting %s: %s", toggleFilename, err)
+			}
+			if out != nil {
+				if _, err := out.Write(code); err != nil {
+					log.Fatalf("failed to write result: %s", err)
+				}
+			} else {
+				// The file's contents only depend on the package name, so it's
+				// safe to overwrite one written for another input package.
+				writeFile(filepath.Join(pkg.OutputDir, toggleFilename), code, opts)
+			}
+		}
 
-func NewPackage(inputDir, outputDir string) *Package {
-	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax, BuildFlags: []string{"-tags=nostubs"}}, inputDir)
-	if err != nil {
-		panic(err)
+		if test := scaffold(pkg, opts.Recordings); test != nil {
+			if out != nil {
+				if _, err := out.Write(test); err != nil {
+					log.Fatalf("failed to write result: %s", err)
+				}
+				continue
+			}
+			testFilename := strings.TrimSuffix(pkg.Filename(), ".go") + "_test.go"
+			if _, err := os.Stat(testFilename); err == nil {
+				// Scaffolded tests are meant to be edited, so never clobber one.
+				log.Printf("not overwriting existing test %s", testFilename)
+				continue
+			}
+			writeFile(testFilename, test, opts)
+		}
 	}
 
-	absOutputDir, err := filepath.Abs(outputDir)
-	if err != nil {
-		panic(err)
+	if opts.Manifest != "" {
+		writeManifest(outputs, out == nil, opts)
 	}
+}
 
-	p := Package{
-		InputName:       pkgs[0].Name,
-		OutputName:      filepath.Base(absOutputDir),
-		Pkg:             pkgs[0],
-		Dependencies:    make(map[string]struct{}),
-		DependencyNames: make(map[string]struct{}),
+// writeFile writes code to filename with opts.FileMode, or if opts.DryRun is
+// set, only logs what would be written.
+func writeFile(filename string, code []byte, opts Options) {
+	if opts.DryRun {
+		log.Printf("would write %s (%d bytes)", filename, len(code))
+		return
 	}
-	if outputDir == "" {
-		p.OutputName = "stubs"
+	log.Printf("writing %s", filename)
+	if err := ioutil.WriteFile(filename, code, opts.FileMode); err != nil {
+		log.Fatalf("failed to write output file %s: %s", filename, err)
+	}
+	// The mode passed to WriteFile is masked by the umask, and isn't applied
+	// to a file that already exists.
+	if err := os.Chmod(filename, opts.FileMode); err != nil {
+		log.Fatalf("failed to set the mode of output file %s: %s", filename, err)
 	}
-	return &p
 }
 
-func ImportPath(pkgPath string) string {
-	parts := strings.Split(pkgPath, "/")
-	for len(parts) > 0 {
+// unrecordable returns the kind of value within t, if any, that prevents a
+// recorded call from being meaningfully compared: a chan, func or map.
+func unrecordable(t types.Type) string {
+	return unrecordableKind(t, make(map[types.Type]bool))
+}
+
+func unrecordableKind(t types.Type, seen map[types.Type]bool) string {
+	if seen[t] {
+		return ""
+	}
+	seen[t] = true
+	switch u := t.Underlying().(type) {
+	case *types.Chan:
+		return "chan"
+	case *types.Signature:
+		return "func"
+	case *types.Map:
+		return "map"
+	case *types.Slice:
+		return unrecordableKind(u.Elem(), seen)
+	case *types.Array:
+		return unrecordableKind(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if kind := unrecordableKind(u.Field(i).Type(), seen); kind != "" {
+				return kind
+			}
+		}
+	}
+	return ""
+}
+
+// ensureOutputDir creates dir if it doesn't exist. A symlink to a directory
+// is used as is, but a broken symlink is reported as such, since MkdirAll
+// would only fail with a confusing "file exists" error.
+func ensureOutputDir(dir string) {
+	if info, err := os.Lstat(dir); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if _, err := os.Stat(dir); err != nil {
+			target, _ := os.Readlink(dir)
+			log.Fatalf("output directory %s is a broken symlink to %s", dir, target)
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Fatalf("cannot make output directory: %s", err)
+	}
+}
+
+// contextLines is the number of lines shown on either side of an error in
+// generated source.
+const contextLines = 5
+
+// sourceContext returns the numbered lines of src surrounding each position
+// reported by err, or all of src if err doesn't report any.
+func sourceContext(src []byte, err error) string {
+	list, ok := err.(scanner.ErrorList)
+	if !ok || len(list) == 0 {
+		return string(src)
+	}
+
+	lines := strings.Split(string(src), "\n")
+	var buf bytes.Buffer
+	for i, e := range list {
+		if i > 0 {
+			buf.WriteString("...\n")
+		}
+		from, to := e.Pos.Line-contextLines, e.Pos.Line+contextLines
+		if from < 1 {
+			from = 1
+		}
+		if to > len(lines) {
+			to = len(lines)
+		}
+		for n := from; n <= to; n++ {
+			marker := " "
+			if n == e.Pos.Line {
+				marker = ">"
+			}
+			fmt.Fprintf(&buf, "%s%5d  %s\n", marker, n, lines[n-1])
+		}
+	}
+	return buf.String()
+}
+
+type Package struct {
+	// OutputName is the name of the output package.
+	OutputName string
+	// OutputDir is the directory that the output package is written to.
+	OutputDir string
+	// InputName is the name of the input package.
+	InputName string
+	// InPackage is set if the output directory is the input package's own
+	// directory, so that the stubs are written into the input package.
+	InPackage bool
+	// Merged is set if p combines the interfaces of several input packages,
+	// in which case Pkg is the first of them.
+	Merged     bool
+	Pkg        *packages.Package
+	Interfaces []*Interface
+	// Dependencies maps the import path of each package imported by the
+	// stubs to its alias, which is empty unless another imported package
+	// has the same name.
+	Dependencies map[string]string
+	// Imports holds the same packages as Dependencies, sorted by import
+	// path, so that the stubs import them in the same order on every run.
+	Imports []Import
+	// DependencyNames holds the names by which the imported packages are
+	// referred to.
+	DependencyNames map[string]struct{}
+	// FileName, if set, replaces the input package's name in the name of the
+	// file the stubs are written to, and in the names of stubs that would
+	// otherwise clash. It's set when another input package of the same name
+	// is written to the same directory.
+	FileName string
+	Options  Options
+	// importNames maps the import path of each imported package to the name
+	// by which it's referred to.
+	importNames map[string]string
+}
+
+func NewPackage(inputDir, outputDir string, opts Options) *Package {
+	// The input may also be a single file, in which case the package
+	// containing it is loaded, or an import path, which is resolved against
+	// the current module.
+	dir, pattern := inputDir, "."
+	importPath := isImportPath(inputDir)
+	if importPath {
+		// Unlike with ImportPath, the path is loaded exactly as given, since
+		// a suffix of it may name another package, e.g. example.com/x/errors
+		// and the standard library's errors.
+		dir, pattern = "", inputDir
+	} else if info, err := os.Stat(inputDir); err == nil && !info.IsDir() {
+		file, err := filepath.Abs(inputDir)
+		if err != nil {
+			log.Fatalf("failed to resolve %s: %s", inputDir, err)
+		}
+		dir, pattern = filepath.Dir(file), "file="+file
+	}
+
+	// Load from within the input directory so that it's resolved against its
+	// own module, which may not be the one containing the output directory.
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.LoadAllSyntax,
+		Dir:        dir,
+		BuildFlags: []string{"-tags=" + strings.Join(opts.loadTags(), ",")},
+		Overlay:    opts.Overlay,
+		Tests:      opts.Tests,
+	}, pattern)
+	if err != nil {
+		panic(err)
+	}
+	if importPath {
+		// A package that can't be found is still returned, with the reason
+		// in its errors.
+		var errs []string
+		for _, pkg := range pkgs {
+			for _, e := range pkg.Errors {
+				errs = append(errs, e.Error())
+			}
+		}
+		if len(errs) > 0 {
+			log.Fatalf("unable to import package %s:\n\t%s", inputDir, strings.Join(errs, "\n\t"))
+		}
+	}
+	pkg := selectPackage(pkgs, opts.PkgPath, opts.Tests)
+	if pkg == nil {
+		log.Fatalf("no package matching %q found in %s", opts.PkgPath, inputDir)
+	}
+
+	p := Package{
+		InputName:       pkg.Name,
+		OutputName:      outputName(outputDir),
+		OutputDir:       outputDir,
+		Pkg:             pkg,
+		Dependencies:    make(map[string]string),
+		DependencyNames: make(map[string]struct{}),
+		Options:         opts,
+		importNames:     make(map[string]string),
+	}
+	if isPackageDir(pkg, outputDir) {
+		p.InPackage = true
+		p.OutputName = pkg.Name
+	}
+	return &p
+}
+
+// checkTestFiles exits if p has an interface declared in a test file but its
+// stubs wouldn't be written to a test file in the same package, where the
+// interface can be referred to.
+func (p *Package) checkTestFiles() {
+	for _, iface := range p.Interfaces {
+		if iface.InTestFile && (!p.InPackage || !strings.HasSuffix(p.Filename(), "_test.go")) {
+			log.Fatalf("%s is declared in a test file, so its stub must be written to a _test.go file in its package, e.g. with -filename {{.Name}}_stubs_test.go", iface.QualName)
+		}
+	}
+}
+
+// Merge combines the interfaces of pkgs, which share an output directory, into
+// one package, so that their stubs are written to a single file.
+func Merge(pkgs []*Package) *Package {
+	p := &Package{
+		InputName:  pkgs[0].InputName,
+		OutputName: pkgs[0].OutputName,
+		OutputDir:  pkgs[0].OutputDir,
+		Merged:     len(pkgs) > 1,
+		Pkg:        pkgs[0].Pkg,
+		Options:    pkgs[0].Options,
+	}
+	for _, pkg := range pkgs {
+		if pkg.InPackage && p.Merged {
+			log.Fatalf("cannot write the stubs of several packages into %s, which is the directory of %s", pkg.OutputDir, pkg.InputName)
+		}
+		p.InPackage = pkg.InPackage
+		for _, iface := range pkg.Interfaces {
+			iface.Pkg = p
+			p.Interfaces = append(p.Interfaces, iface)
+		}
+	}
+	p.resolveDependencies()
+	return p
+}
+
+// fileName returns the name that the stubs of p are named after: FileName
+// if it's set, and otherwise the input package's name.
+func (p *Package) fileName() string {
+	if p.FileName != "" {
+		return p.FileName
+	}
+	return p.Pkg.Name
+}
+
+// stubInPackage reports whether the stub for iface is written into the
+// package declaring the interface, taking Options.OutputMap into account.
+func (p *Package) stubInPackage(iface *Interface) bool {
+	if dir, ok := p.Options.OutputMap[iface.QualName]; ok {
+		return isPackageDir(iface.Source, dir)
+	}
+	return p.InPackage
+}
+
+// InPackagePrefix is the prefix given to the names of stubs written into the
+// package declaring their interfaces, unless another prefix or suffix is
+// set.
+const InPackagePrefix = "Stubbed"
+
+// isPackageDir reports whether dir is the directory containing pkg's files.
+func isPackageDir(pkg *packages.Package, dir string) bool {
+	if dir == "" || len(pkg.GoFiles) == 0 {
+		return false
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	return absDir == filepath.Dir(pkg.GoFiles[0])
+}
+
+// DefaultFilename is the template for the name of the file that stubs are
+// written to when Options.Filename isn't set.
+const DefaultFilename = "{{.Name}}_stubs.go"
+
+// DefaultFileMode is the mode of the files written by Main when
+// Options.FileMode isn't set.
+const DefaultFileMode os.FileMode = 0644
+
+// Filename returns the path of the file that p's stubs are written to, by
+// executing the Options.Filename template with the input package's name as
+// .Name, or the output package's name if p combines several input packages.
+func (p *Package) Filename() string {
+	pattern := p.Options.Filename
+	if pattern == "" {
+		pattern = DefaultFilename
+	}
+	tmpl, err := template.New("filename").Parse(pattern)
+	if err != nil {
+		log.Fatalf("invalid filename template: %s", err)
+	}
+	var buf strings.Builder
+	name := p.fileName()
+	if p.Merged {
+		name = p.OutputName
+	}
+	if err := tmpl.Execute(&buf, struct{ Name string }{name}); err != nil {
+		log.Fatalf("invalid filename template: %s", err)
+	}
+	name = filepath.Clean(buf.String())
+	if buf.Len() == 0 || name == "." {
+		log.Fatalf("filename template %q gives an empty filename", pattern)
+	}
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		log.Fatalf("filename %s must be within the output directory", name)
+	}
+	return filepath.Join(p.OutputDir, name)
+}
+
+// DefaultComment is the text of the comment at the top of each generated
+// file when Options.Comment isn't set.
+const DefaultComment = "This file was generated by stubber; DO NOT EDIT"
+
+// DefaultBuildTag is the build constraint of the generated files when
+// Options.BuildTag isn't set. Stubs are excluded by the nostubs tag, so that
+// they're ignored when finding interfaces.
+const DefaultBuildTag = "!nostubs"
+
+// NoBuildTag is the Options.BuildTag that omits the build constraint.
+const NoBuildTag = "none"
+
+// HeaderComment returns the comment at the top of the files generated for p,
+// with each line of Options.Comment commented out.
+func (p *Package) HeaderComment() string {
+	text := p.Options.Comment
+	if text == "" {
+		text = DefaultComment
+	}
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// BuildConstraint returns the build constraint of the files generated for p,
+// in //go:build syntax, or "" if they have none.
+func (p *Package) BuildConstraint() string {
+	return p.Options.buildConstraint()
+}
+
+func (opts Options) buildConstraint() string {
+	tag := opts.BuildTag
+	if tag == "" {
+		tag = DefaultBuildTag
+	}
+	if tag == NoBuildTag {
+		tag = ""
+	}
+	if opts.TestOnly {
+		if tag == "" {
+			return testOnlyTag
+		}
+		return testOnlyTag + " && (" + tag + ")"
+	}
+	return tag
+}
+
+// loadTags returns the build tags set when loading an input package, which
+// exclude any stubs previously generated into it. The nostubs tag is always
+// set, so that stubs generated with the default constraint are excluded too,
+// along with the fewest other tags of the constraint that are needed to
+// exclude stubs generated with it.
+func (opts Options) loadTags() []string {
+	tags := []string{"nostubs"}
+	tag := opts.buildConstraint()
+	if tag == "" {
+		return tags
+	}
+	expr, err := constraint.Parse("//go:build " + tag)
+	if err != nil {
+		log.Fatalf("invalid build tag %q: %s", tag, err)
+	}
+	seen := map[string]bool{"nostubs": true}
+	var names []string
+	for _, name := range constraintTags(expr) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) > 16 {
+		log.Fatalf("build tag %q has too many tags to exclude stubs when loading", tag)
+	}
+	// Try each subset of names, keeping the smallest that excludes stubs.
+	var best []string
+	found := false
+	for set := 0; set < 1<<len(names); set++ {
+		enabled := map[string]bool{"nostubs": true}
+		var subset []string
+		for i, name := range names {
+			if set&(1<<i) != 0 {
+				enabled[name] = true
+				subset = append(subset, name)
+			}
+		}
+		if found && len(subset) >= len(best) {
+			continue
+		}
+		if !expr.Eval(func(name string) bool { return enabled[name] }) {
+			best, found = subset, true
+		}
+	}
+	if !found {
+		log.Fatalf("build tag %q doesn't exclude stubs when loading with any of its tags set", tag)
+	}
+	return append(tags, best...)
+}
+
+// constraintTags returns the tags in expr, in the order they appear.
+func constraintTags(expr constraint.Expr) []string {
+	switch expr := expr.(type) {
+	case *constraint.TagExpr:
+		return []string{expr.Tag}
+	case *constraint.NotExpr:
+		return constraintTags(expr.X)
+	case *constraint.AndExpr:
+		return append(constraintTags(expr.X), constraintTags(expr.Y)...)
+	case *constraint.OrExpr:
+		return append(constraintTags(expr.X), constraintTags(expr.Y)...)
+	}
+	return nil
+}
+
+// BuildLines returns the lines of the build constraint of the files
+// generated for p: a //go:build line followed by the equivalent // +build
+// lines, which gofmt expects to match it.
+func (p *Package) BuildLines() []string {
+	tag := p.BuildConstraint()
+	if tag == "" {
+		return nil
+	}
+	expr, err := constraint.Parse("//go:build " + tag)
+	if err != nil {
+		log.Fatalf("invalid build tag %q: %s", tag, err)
+	}
+	plusLines, err := constraint.PlusBuildLines(expr)
+	if err != nil {
+		log.Fatalf("invalid build tag %q: %s", tag, err)
+	}
+	return append([]string{"//go:build " + expr.String()}, plusLines...)
+}
+
+// selectPackage returns the package to stub out of those that were loaded,
+// which may include test variants. If pkgPath is set, only a package with that
+// import path is considered. Non-test variants are preferred; a test variant
+// has an ID that differs from its import path, e.g. "bank [bank.test]". If
+// tests is set, the variant including the package's own test files is
+// preferred instead, which contains all of its files, so each interface is
+// found exactly once.
+func selectPackage(pkgs []*packages.Package, pkgPath string, tests bool) *packages.Package {
+	var fallback *packages.Package
+	for _, pkg := range pkgs {
+		if pkgPath != "" && pkg.PkgPath != pkgPath {
+			continue
+		}
+		want := pkg.PkgPath
+		if tests {
+			want += " [" + pkg.PkgPath + ".test]"
+		}
+		if pkg.ID == want {
+			return pkg
+		}
+		if fallback == nil {
+			fallback = pkg
+		}
+	}
+	return fallback
+}
+
+// outputName returns the name of the package written to outputDir.
+func outputName(outputDir string) string {
+	if outputDir == "" {
+		return "stubs"
+	}
+	absOutputDir, err := filepath.Abs(outputDir)
+	if err != nil {
+		panic(err)
+	}
+	name := SanitizePackageName(absOutputDir)
+	if name != filepath.Base(absOutputDir) {
+		log.Printf("using package name %s for %s", name, outputDir)
+	}
+	return name
+}
+
+// SanitizePackageName returns a valid package name for files in dir, based
+// on its final element. A major version directory like v2 is named after its
+// parent, as its package usually is. Characters that can't appear in an
+// identifier are removed, and a leading digit or a keyword is prefixed with
+// p, e.g. go-stuff becomes gostuff and 3d becomes p3d.
+func SanitizePackageName(dir string) string {
+	base := filepath.Base(dir)
+	isMajorVersion := len(base) > 1 && base[0] == 'v' && strings.Trim(base[1:], "0123456789") == ""
+	if parent := filepath.Base(filepath.Dir(dir)); isMajorVersion && parent != "." && parent != string(filepath.Separator) {
+		base = parent
+	}
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, base)
+	switch {
+	case name == "":
+		return "stubs"
+	case unicode.IsDigit([]rune(name)[0]), token.IsKeyword(name), name == "_":
+		return "p" + name
+	}
+	return name
+}
+
+// Split moves any of p's interfaces that outputMap routes to a different
+// output directory into new packages, one per directory, and returns them
+// after p.
+func (p *Package) Split(outputMap map[string]string) []*Package {
+	result := []*Package{p}
+	byDir := make(map[string]*Package)
+
+	var kept []*Interface
+	for _, iface := range p.Interfaces {
+		dir, ok := outputMap[iface.QualName]
+		if !ok || filepath.Clean(dir) == filepath.Clean(p.OutputDir) {
+			kept = append(kept, iface)
+			continue
+		}
+		q := byDir[dir]
+		if q == nil {
+			q = &Package{
+				InputName:  iface.Source.Name,
+				OutputName: outputName(dir),
+				OutputDir:  dir,
+				InPackage:  isPackageDir(iface.Source, dir),
+				Pkg:        iface.Source,
+				FileName:   p.FileName,
+				Options:    p.Options,
+			}
+			if q.InPackage {
+				q.OutputName = iface.Source.Name
+			}
+			byDir[dir] = q
+			result = append(result, q)
+		}
+		iface.Pkg = q
+		q.Interfaces = append(q.Interfaces, iface)
+	}
+	if len(result) == 1 {
+		return result
+	}
+
+	p.Interfaces = kept
+	for _, q := range result {
+		q.resolveDependencies()
+	}
+	return result
+}
+
+// ImportPath returns the import path of the package at pkgPath, or at the
+// longest suffix of it that can be loaded, e.g. so that a path within GOPATH
+// such as src/github.com/dradtke/stubber can be used.
+func ImportPath(pkgPath string) string {
+	parts := strings.Split(pkgPath, "/")
+	for len(parts) > 0 {
 		path := strings.Join(parts, "/")
-		if _, err := packages.Load(nil, path); err == nil {
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName}, path)
+		if err == nil && len(pkgs) == 1 && len(pkgs[0].Errors) == 0 {
 			log.Println("package " + pkgPath + " successfully imported")
 			return path
 		}
@@ -300,9 +2153,24 @@ func ImportPath(pkgPath string) string {
 	return ""
 }
 
+// isImportPath reports whether input names a package by its import path
+// rather than by a file or directory, which must either exist or be
+// relative or absolute.
+func isImportPath(input string) bool {
+	if _, err := os.Stat(input); err == nil {
+		return false
+	}
+	return !filepath.IsAbs(input) && !strings.HasPrefix(input, ".")
+}
+
 func findInterfaceDefs(pkg *packages.Package) map[*ast.Ident]types.Object {
 	m := make(map[*ast.Ident]types.Object)
 	for _, f := range pkg.Syntax {
+		// Never stub stubber's own output, even if it was built because it
+		// lacks the nostubs constraint.
+		if isGenerated(pkg.Fset.Position(f.Pos()).Filename) {
+			continue
+		}
 		for _, decl := range f.Decls {
 			if gen, ok := decl.(*ast.GenDecl); ok {
 				if gen.Tok == token.TYPE {
@@ -320,79 +2188,787 @@ func findInterfaceDefs(pkg *packages.Package) map[*ast.Ident]types.Object {
 	return m
 }
 
-func (p *Package) Check(ts []string) {
-	p.Dependencies[p.Pkg.PkgPath] = struct{}{}
+// methodDocs returns the doc comments of the methods declared in pkg, either
+// by interfaces or with receivers, keyed by the methods' objects. Methods
+// without a doc comment are omitted.
+func methodDocs(pkg *packages.Package) map[types.Object]string {
+	docs := make(map[types.Object]string)
+	for _, f := range pkg.Syntax {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if decl, ok := n.(*ast.FuncDecl); ok {
+				if decl.Recv != nil && decl.Doc != nil {
+					docs[pkg.TypesInfo.Defs[decl.Name]] = decl.Doc.Text()
+				}
+				return false
+			}
+			itype, ok := n.(*ast.InterfaceType)
+			if !ok {
+				return true
+			}
+			for _, field := range itype.Methods.List {
+				if field.Doc == nil {
+					continue
+				}
+				for _, name := range field.Names {
+					if obj := pkg.TypesInfo.Defs[name]; obj != nil {
+						docs[obj] = field.Doc.Text()
+					}
+				}
+			}
+			return true
+		})
+	}
+	return docs
+}
+
+// IgnoreDirective is a comment that excludes the method it's attached to from
+// its stub, e.g. a deprecated method that tests shouldn't use.
+const IgnoreDirective = "// stubber:ignore"
+
+// ignoredMethods returns the methods declared in pkg, either by interfaces or
+// with receivers, that have an IgnoreDirective in their doc comment or as a
+// trailing comment.
+func ignoredMethods(pkg *packages.Package) map[types.Object]bool {
+	ignored := make(map[types.Object]bool)
+	for _, f := range pkg.Syntax {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if decl, ok := n.(*ast.FuncDecl); ok {
+				if decl.Recv != nil && hasIgnoreDirective(decl.Doc) {
+					ignored[pkg.TypesInfo.Defs[decl.Name]] = true
+				}
+				return false
+			}
+			itype, ok := n.(*ast.InterfaceType)
+			if !ok {
+				return true
+			}
+			for _, field := range itype.Methods.List {
+				if !hasIgnoreDirective(field.Doc) && !hasIgnoreDirective(field.Comment) {
+					continue
+				}
+				for _, name := range field.Names {
+					if obj := pkg.TypesInfo.Defs[name]; obj != nil {
+						ignored[obj] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+	return ignored
+}
+
+// hasIgnoreDirective reports whether group contains an IgnoreDirective line,
+// with or without the space after the slashes.
+func hasIgnoreDirective(group *ast.CommentGroup) bool {
+	if group == nil {
+		return false
+	}
+	for _, c := range group.List {
+		text := strings.TrimSpace(c.Text)
+		if text == IgnoreDirective || text == strings.Replace(IgnoreDirective, " ", "", 1) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGenerated reports whether filename is named like a file of stubs written
+// by Main.
+func isGenerated(filename string) bool {
+	return strings.HasSuffix(filename, "_stubs.go")
+}
+
+func (p *Package) Check(ts []string) {
+	docs := methodDocs(p.Pkg)
+	ignored := ignoredMethods(p.Pkg)
+	for ident, def := range findInterfaceDefs(p.Pkg) {
+		if p.Options.ExportedOnly && !ident.IsExported() {
+			continue
+		}
+		// If any type names were specified, make sure this type was included.
+		if len(ts) > 0 || p.Options.TypesRegexp != nil {
+			include := p.Options.TypesRegexp != nil && p.Options.TypesRegexp.MatchString(ident.Name)
+			for _, typ := range ts {
+				if typ == ident.Name {
+					include = true
+					break
+				}
+			}
+			if !include {
+				continue
+			}
+		}
+		if p.Options.excluded(ident.Name) {
+			continue
+		}
+
+		iface := Interface{
+			Pkg:        p,
+			Name:       ident.Name,
+			QualName:   p.InputName + "." + ident.Name,
+			StubName:   ident.Name,
+			Source:     p.Pkg,
+			InTestFile: strings.HasSuffix(p.Pkg.Fset.Position(ident.Pos()).Filename, "_test.go"),
+		}
+
+		itype := def.Type().Underlying().(*types.Interface)
+		if !itype.IsMethodSet() {
+			// Interfaces with type terms, e.g. ~int | ~string, can only be
+			// used as constraints, so no stub could implement them.
+			log.Printf("skipping %s: constraint interface, cannot stub", iface.QualName)
+			continue
+		}
+		// An alias, e.g. type Source = io.ByteReader, is stubbed under its
+		// own name. It has no type parameters of its own, even if it refers
+		// to an instance of a generic interface.
+		if !def.(*types.TypeName).IsAlias() {
+			iface.TypeParams = def.Type().(*types.Named).TypeParams()
+		}
+		for i := 0; i < itype.NumMethods(); i++ {
+			method := itype.Method(i)
+			if method.Name() == "_" {
+				continue
+			}
+			if ignored[method] {
+				iface.Partial = true
+				continue
+			}
+
+			sig := method.Type().(*types.Signature)
+			ifunc := Func{
+				Interface: &iface,
+				Name:      method.Name(),
+				Pkg:       p.Pkg.Types,
+				Signature: sig,
+				Doc:       docs[method],
+			}
+
+			iface.Funcs = append(iface.Funcs, ifunc)
+		}
+
+		// Sort explicitly so that the output never depends on the order in
+		// which the type checker lists methods, e.g. for embedded interfaces.
+		sort.Slice(iface.Funcs, func(i, j int) bool {
+			return iface.Funcs[i].Name < iface.Funcs[j].Name
+		})
+		if iface.Partial {
+			log.Printf("%s has ignored methods, so its stub won't implement it", iface.QualName)
+		}
+		p.Interfaces = append(p.Interfaces, &iface)
+	}
+
+	p.checkMethodsOf(p.Options.MethodsOf, docs, ignored)
+
+	// findInterfaceDefs returns a map, so sort to keep the output stable.
+	sort.Slice(p.Interfaces, func(i, j int) bool {
+		return p.Interfaces[i].Name < p.Interfaces[j].Name
+	})
+
+	p.resolveDependencies()
+}
+
+// checkMethodsOf adds a stub to p for the method set of each concrete type in
+// names that p declares. Ignored methods are left out of both the stub and the
+// interface declared for it, so the stub still implements that interface.
+func (p *Package) checkMethodsOf(names []string, docs map[types.Object]string, ignored map[types.Object]bool) {
+	for _, name := range names {
+		obj, ok := p.Pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || types.IsInterface(named) {
+			log.Fatalf("%s.%s is not a concrete named type", p.InputName, name)
+		}
+		if named.TypeParams().Len() > 0 {
+			log.Printf("skipping %s.%s: cannot stub the methods of a generic type", p.InputName, name)
+			continue
+		}
+
+		iface := Interface{
+			Pkg:      p,
+			Name:     name,
+			QualName: p.InputName + "." + name,
+			StubName: name,
+			Source:   p.Pkg,
+			Concrete: true,
+		}
+		// The method set of a pointer includes methods with either kind of
+		// receiver.
+		mset := types.NewMethodSet(types.NewPointer(named))
+		for i := 0; i < mset.Len(); i++ {
+			method := mset.At(i).Obj()
+			if !method.Exported() || ignored[method] {
+				continue
+			}
+			iface.Funcs = append(iface.Funcs, Func{
+				Interface: &iface,
+				Name:      method.Name(),
+				Pkg:       p.Pkg.Types,
+				Signature: method.Type().(*types.Signature),
+				Doc:       docs[method],
+			})
+		}
+		sort.Slice(iface.Funcs, func(i, j int) bool {
+			return iface.Funcs[i].Name < iface.Funcs[j].Name
+		})
+		p.Interfaces = append(p.Interfaces, &iface)
+	}
+}
+
+// declaresType reports whether any of pkgs declares a type named name.
+func declaresType(pkgs []*Package, name string) bool {
+	for _, pkg := range pkgs {
+		if _, ok := pkg.Pkg.Types.Scope().Lookup(name).(*types.TypeName); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveDependencies determines the packages that need to be imported by the
+// stubs for p's interfaces, and the names by which they're referred to.
+func (p *Package) resolveDependencies() {
+	// The packages used by the template itself are imported first, so that
+	// they're never aliased.
+	fixed := make(map[string]string)
+	if p.Options.Style == TestifyStyle {
+		// The options that add to the default style's stubs don't apply to
+		// testify mocks.
+		fixed[testifyMockPath] = "mock"
+	} else {
+		p.templateDependencies(fixed)
+	}
+
+	deps := make(map[string]string)
+	for _, iface := range p.Interfaces {
+		for j := 0; j < iface.TypeParams.Len(); j++ {
+			collectDependencies(iface.TypeParams.At(j).Constraint(), deps)
+		}
+		for _, ifunc := range iface.Funcs {
+			for j := 0; j < ifunc.Signature.Params().Len(); j++ {
+				collectDependencies(ifunc.Signature.Params().At(j).Type(), deps)
+			}
+
+			for j := 0; j < ifunc.Signature.Results().Len(); j++ {
+				collectDependencies(ifunc.Signature.Results().At(j).Type(), deps)
+			}
+		}
+	}
+
+	p.Dependencies = make(map[string]string)
+	p.DependencyNames = make(map[string]struct{})
+	p.importNames = make(map[string]string)
+	for _, path := range sortedKeys(fixed) {
+		p.addImport(path, fixed[path])
+	}
+	if p.InPackage {
+		delete(deps, canonicalPath(p.Pkg.PkgPath))
+	} else {
+		for _, iface := range p.Interfaces {
+			if iface.refersToSource() {
+				p.addImport(canonicalPath(iface.Source.PkgPath), iface.Source.Name)
+			}
+		}
+	}
+	for _, path := range sortedKeys(deps) {
+		p.addImport(path, deps[path])
+	}
+	p.Imports = make([]Import, 0, len(p.Dependencies))
+	for _, path := range sortedKeys(p.Dependencies) {
+		p.Imports = append(p.Imports, Import{Path: path, Alias: p.Dependencies[path]})
+	}
+}
+
+// Import is a package imported by the stubs.
+type Import struct {
+	Path string
+	// Alias is the name the package is imported as, or empty if it's
+	// imported under its own name.
+	Alias string
+}
+
+// templateDependencies adds the packages used by the default style's template
+// for p's options to fixed.
+func (p *Package) templateDependencies(fixed map[string]string) {
+	if p.Options.Unexpected == UnexpectedFail || p.Options.CallLog || p.Options.AssertUsed || p.Options.Verify {
+		fixed["testing"] = "testing"
+	}
+	if p.Options.Unexpected == UnexpectedWarn {
+		fixed["fmt"] = "fmt"
+		fixed["os"] = "os"
+	}
+	if p.Options.CountByArg {
+		for _, iface := range p.Interfaces {
+			for i := range iface.Funcs {
+				if f := &iface.Funcs[i]; f.CanCountByArg() && !f.CountKeyIsArg() {
+					fixed["fmt"] = "fmt"
+				}
+			}
+		}
+	}
+	for _, iface := range p.Interfaces {
+		if iface.HasRequired() {
+			fixed["fmt"] = "fmt"
+			fixed["reflect"] = "reflect"
+		}
+	}
+	if p.Options.Concurrent {
+		fixed["sync"] = "sync"
+	}
+	if p.Options.Marshal {
+		fixed["bytes"] = "bytes"
+		fixed["encoding/json"] = "json"
+	}
+	if p.Options.Sink || p.Options.CallStore || p.Options.Gomock {
+		fixed[supportPath] = "support"
+	}
+	if p.Options.VerbosePanic {
+		for _, iface := range p.Interfaces {
+			for i := range iface.Funcs {
+				if iface.Funcs[i].Signature.Params().Len() > 0 {
+					fixed["fmt"] = "fmt"
+				}
+			}
+		}
+	}
+}
+
+// refersToSource reports whether i's stub refers to the interface itself,
+// which it doesn't if it's partial.
+func (i *Interface) refersToSource() bool {
+	return !i.Partial || i.Concrete || i.Pkg.Options.Spy
+}
+
+// addImport adds the package at importPath, named name, to p's dependencies,
+// aliasing it if another dependency already has the same name.
+func (p *Package) addImport(importPath, name string) {
+	if _, ok := p.importNames[importPath]; ok {
+		return
+	}
+	var alias string
+	if _, ok := p.DependencyNames[name]; ok {
+		alias = importAlias(importPath, name, p.DependencyNames)
+		name = alias
+	}
+	p.Dependencies[importPath] = alias
+	p.DependencyNames[name] = struct{}{}
+	p.importNames[importPath] = name
+}
+
+// Local returns name, with underscores appended if necessary so that a
+// variable or parameter with that name in a generated method doesn't shadow
+// the method's receiver.
+func (p *Package) Local(name string) string {
+	for name == p.Options.ReceiverName {
+		name += "_"
+	}
+	return name
+}
+
+// importName returns the name by which the stubs for p refer to pkg.
+func (p *Package) importName(pkg *types.Package) string {
+	if p.InPackage && canonicalPath(pkg.Path()) == canonicalPath(p.Pkg.PkgPath) {
+		return ""
+	}
+	if name, ok := p.importNames[canonicalPath(pkg.Path())]; ok {
+		return name
+	}
+	return pkg.Name()
+}
+
+// importAlias returns an alias for the package at importPath, named name,
+// that isn't in taken. It prefers to prefix name with the last element of the
+// package's parent directory, e.g. "foov1" for "example.com/foo/v1", and
+// otherwise numbers it.
+func importAlias(importPath, name string, taken map[string]struct{}) string {
+	base := name
+	if dir := path.Dir(importPath); dir != "." {
+		prefix := strings.Map(func(r rune) rune {
+			if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, path.Base(dir))
+		if token.IsIdentifier(prefix + name) {
+			base = prefix + name
+		}
+	}
+	alias := base
+	for n := 2; ; n++ {
+		if _, ok := taken[alias]; !ok {
+			return alias
+		}
+		alias = base + strconv.Itoa(n)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// canonicalPath returns the path by which the package at pkgPath is imported.
+// In GOPATH mode, the path of a vendored package includes its vendor
+// directory, e.g. "example.com/app/vendor/example.com/dep", but it must be
+// imported as "example.com/dep". Module mode already reports the latter.
+func canonicalPath(pkgPath string) string {
+	if i := strings.LastIndex(pkgPath, "/vendor/"); i >= 0 {
+		return pkgPath[i+len("/vendor/"):]
+	}
+	return strings.TrimPrefix(pkgPath, "vendor/")
+}
+
+// collectDependencies records the packages of the named types that t refers
+// to in deps, which maps their import paths to their names. It descends into
+// composite types, such as the value type of a map or the parameters of a
+// func, since those are rendered as part of t. Types of the universe scope,
+// such as error and any, have no package to import.
+func collectDependencies(t types.Type, deps map[string]string) {
+	switch t := t.(type) {
+	case *types.TypeParam:
+		// A type parameter is declared by the stub itself.
+	case *types.Basic:
+		// Of the basic types, only unsafe.Pointer is declared in a package.
+		if t.Kind() == types.UnsafePointer {
+			deps["unsafe"] = "unsafe"
+		}
+	case *types.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			deps[canonicalPath(pkg.Path())] = pkg.Name()
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			collectDependencies(t.TypeArgs().At(i), deps)
+		}
+	case *types.Pointer:
+		collectDependencies(t.Elem(), deps)
+	case *types.Slice:
+		collectDependencies(t.Elem(), deps)
+	case *types.Array:
+		collectDependencies(t.Elem(), deps)
+	case *types.Chan:
+		collectDependencies(t.Elem(), deps)
+	case *types.Map:
+		collectDependencies(t.Key(), deps)
+		collectDependencies(t.Elem(), deps)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			collectDependencies(t.Field(i).Type(), deps)
+		}
+	case *types.Interface:
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			collectDependencies(t.ExplicitMethod(i).Type(), deps)
+		}
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			collectDependencies(t.EmbeddedType(i), deps)
+		}
+	case *types.Signature:
+		for i := 0; i < t.Params().Len(); i++ {
+			collectDependencies(t.Params().At(i).Type(), deps)
+		}
+		for i := 0; i < t.Results().Len(); i++ {
+			collectDependencies(t.Results().At(i).Type(), deps)
+		}
+	case interface{ Obj() *types.TypeName }:
+		// An alias, e.g. os.FileInfo, is referred to by its own name, so its
+		// package is imported rather than that of the aliased type. Universe
+		// aliases such as any have no package.
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			deps[canonicalPath(pkg.Path())] = pkg.Name()
+		}
+	}
+}
+
+type Interface struct {
+	Pkg                      *Package
+	Name, QualName, StubName string
+	// Source is the package declaring the interface, which is Pkg's input
+	// package unless Pkg combines several of them.
+	Source *packages.Package
+	// Concrete is set if the stub is for the method set of a concrete type
+	// rather than an interface, in which case an interface with the same
+	// methods is declared alongside it.
+	Concrete bool
+	// Partial is set if some of the interface's methods were ignored with
+	// an IgnoreDirective, in which case the stub doesn't implement it.
+	Partial bool
+	// InTestFile is set if the interface is declared in a _test.go file,
+	// which is only possible if Options.Tests is set.
+	InTestFile bool
+	// TypeParams holds the interface's type parameters, or nil if it isn't
+	// generic.
+	TypeParams *types.TypeParamList
+	Funcs      []Func
+	// members maps the fields and methods generated for the stub, e.g.
+	// "Reset" or "Balance.Stub", to their names. It's filled in by
+	// assignMembers.
+	members map[string]string
+}
+
+func (i *Interface) ImplName() string {
+	return i.StubName
+}
+
+// InterfaceName returns the name of the interface that i's stub implements:
+// the interface itself, or the one declared for a concrete type's methods.
+func (i *Interface) InterfaceName() string {
+	if i.Concrete {
+		return i.ImplName() + "Interface"
+	}
+	return i.SourceName()
+}
+
+// SourceName returns the name by which i's stub refers to the interface or
+// concrete type it's for, which is only qualified if the stub is in another
+// package.
+func (i *Interface) SourceName() string {
+	if i.Pkg.InPackage {
+		return i.Name
+	}
+	return i.Pkg.importName(i.Source.Types) + "." + i.Name
+}
+
+// ReceiverType returns the type of the receiver of the methods implementing
+// i, which is a pointer unless stubs are generated with value receivers.
+func (i *Interface) ReceiverType() string {
+	if i.Pkg.Options.ValueReceiver {
+		return i.TypeName()
+	}
+	return "*" + i.TypeName()
+}
+
+// MutexName returns the name of the field guarding the calls recorded by i's
+// stub when concurrent stubs are enabled.
+func (i *Interface) MutexName() string {
+	return ensureNoCollision("mu", i.Pkg.DependencyNames)
+}
+
+// memberName returns the name of the field or method of i's stub identified
+// by key, as named by assignMembers. Every getter for the name of a stub
+// member goes through it.
+func (i *Interface) memberName(key string) string {
+	if i.members == nil {
+		i.assignMembers()
+	}
+	return i.members[key]
+}
+
+// assignMembers names the fields and methods generated for i's stub. Each
+// name has underscores appended if necessary so that it collides neither with
+// one of i's methods, which the stub must implement as they are, nor with a
+// member named before it. Members are named one kind at a time, so that a
+// stub field whose suffix is e.g. "Calls" gives way to the accessor of that
+// name rather than the other way around.
+func (i *Interface) assignMembers() {
+	taken := make(map[string]bool)
+	for j := range i.Funcs {
+		taken[i.Funcs[j].Name] = true
+	}
+	i.members = make(map[string]string)
+	add := func(key, name string) {
+		for taken[name] {
+			name += "_"
+		}
+		taken[name] = true
+		i.members[key] = name
+	}
+
+	real := "Real"
+	if i.Pkg.Options.Delegate {
+		real = "Delegate"
+	}
+	add("Real", real)
+	for _, name := range []string{
+		"TB", "Sink", "callLog", "recorder", "Validate", "AssertAllStubsUsed", "Verify",
+		"MarshalText", "CallLog", "AssertCallOrder", "AssertCallSubsequence", "Reset",
+		"Clone", "EXPECT",
+	} {
+		add(name, name)
+	}
+
+	// The members generated for each method are named after it, given here
+	// as formats taking the method's name and the same with its first letter
+	// lowercased.
+	for _, kind := range []struct{ key, format string }{
+		{"Calls", "%[1]sCalls"},
+		{"CallCount", "%[1]sCallCount"},
+		{"Called", "%[1]sCalled"},
+		{"LastCall", "%[1]sLastCall"},
+		{"FirstMatching", "%[1]sFirstMatching"},
+		{"CallCountByArg", "%[1]sCallCountByArg"},
+		{"nextReturns", "next%[1]sReturns"},
+		{"calls", "%[2]sCalls"},
+		{"callTotal", "%[2]sCallTotal"},
+		{"CallStore", "%[1]sCallStore"},
+		{"AllowNil", "%[1]sAllowNil"},
+		{"Panics", "%[1]sPanics"},
+		{"FailAfter", "%[1]sFailAfter"},
+		{"FailError", "%[1]sFailError"},
+		{"Validate", "%[1]sValidate"},
+		{"Returns", "%[1]sReturns"},
+		{"Stub", "%[1]s" + i.Pkg.Options.StubSuffix},
+	} {
+		for j := range i.Funcs {
+			name := i.Funcs[j].Name
+			add(name+"."+kind.key, fmt.Sprintf(kind.format, name, string(unicode.ToLower(rune(name[0])))+name[1:]))
+		}
+	}
+}
+
+// ConstructorName returns the name of the function returning a new stub for
+// i, which is unexported if the stub is.
+func (i *Interface) ConstructorName() string {
+	name := i.ImplName()
+	if !token.IsExported(name) {
+		return "new" + strings.ToUpper(name[:1]) + name[1:]
+	}
+	return "New" + name
+}
+
+// VerifyName returns the name of the method checking that set stubs ran.
+func (i *Interface) VerifyName() string {
+	return i.memberName("Verify")
+}
+
+// ResetName returns the name of the method clearing the stub's recorded calls.
+func (i *Interface) ResetName() string {
+	return i.memberName("Reset")
+}
+
+// RealName returns the name of the field holding the implementation spied on.
+func (i *Interface) RealName() string {
+	return i.memberName("Real")
+}
+
+// TBName returns the name of the field holding i's testing.TB.
+func (i *Interface) TBName() string {
+	return i.memberName("TB")
+}
+
+// SinkName returns the name of the field that is sent an event for every call.
+func (i *Interface) SinkName() string {
+	return i.memberName("Sink")
+}
+
+// LogName returns the name of the field holding the names of the methods
+// called on i's stub when call logs are enabled.
+func (i *Interface) LogName() string {
+	return i.memberName("callLog")
+}
+
+// CallLogName returns the name of the method returning the stub's call log.
+func (i *Interface) CallLogName() string {
+	return i.memberName("CallLog")
+}
 
-	for ident, def := range findInterfaceDefs(p.Pkg) {
-		// If any type names were specified, make sure this type was included.
-		if len(ts) > 0 {
-			var include bool
-			for _, typ := range ts {
-				if typ == ident.Name {
-					include = true
-					break
-				}
-			}
-			if !include {
-				continue
-			}
-		}
+// CallOrderName returns the name of the method asserting the call order.
+func (i *Interface) CallOrderName() string {
+	return i.memberName("AssertCallOrder")
+}
 
-		iface := Interface{
-			Pkg:      p,
-			Name:     ident.Name,
-			QualName: p.InputName + "." + ident.Name,
-			StubName: ident.Name,
-		}
+// CallSubsequenceName returns the name of the method asserting call subsequences.
+func (i *Interface) CallSubsequenceName() string {
+	return i.memberName("AssertCallSubsequence")
+}
 
-		itype := def.Type().Underlying().(*types.Interface)
-		for i := 0; i < itype.NumMethods(); i++ {
-			method := itype.Method(i)
-			if method.Name() == "_" {
-				continue
-			}
+// ValidateName returns the name of the method checking required stubs.
+func (i *Interface) ValidateName() string {
+	return i.memberName("Validate")
+}
 
-			sig := method.Type().(*types.Signature)
-			ifunc := Func{
-				Interface: &iface,
-				Name:      method.Name(),
-				Pkg:       p.Pkg.Types,
-				Signature: sig,
-			}
+// AssertUsedName returns the name of the method checking that stubs are used.
+func (i *Interface) AssertUsedName() string {
+	return i.memberName("AssertAllStubsUsed")
+}
 
-			for j := 0; j < ifunc.Signature.Params().Len(); j++ {
-				if named, ok := indirect(ifunc.Signature.Params().At(j).Type()).(*types.Named); ok {
-					if pkg := named.Obj().Pkg(); pkg != nil {
-						p.Dependencies[pkg.Path()] = struct{}{}
-						p.DependencyNames[pkg.Name()] = struct{}{}
-					}
-				}
-			}
+// MarshalTextName returns the name of the method encoding i's recorded calls.
+func (i *Interface) MarshalTextName() string {
+	return i.memberName("MarshalText")
+}
 
-			for j := 0; j < ifunc.Signature.Results().Len(); j++ {
-				if named, ok := indirect(ifunc.Signature.Results().At(j).Type()).(*types.Named); ok {
-					if pkg := named.Obj().Pkg(); pkg != nil {
-						p.Dependencies[pkg.Path()] = struct{}{}
-						p.DependencyNames[pkg.Name()] = struct{}{}
-					}
-				}
-			}
+// CloneName returns the name of the method copying i's stub.
+func (i *Interface) CloneName() string {
+	return i.memberName("Clone")
+}
 
-			iface.Funcs = append(iface.Funcs, ifunc)
+// RecorderFieldName returns the name of the field holding the recorder of
+// i's stub when gomock-style expectations are enabled.
+func (i *Interface) RecorderFieldName() string {
+	return i.memberName("recorder")
+}
+
+// ExpectName returns the name of the method returning i's recorder.
+func (i *Interface) ExpectName() string {
+	return i.memberName("EXPECT")
+}
+
+// RecorderName returns the name of the type that sets the stubs of i's stub
+// from expected calls.
+func (i *Interface) RecorderName() string {
+	return i.ImplName() + "Recorder"
+}
+
+// AccessorName returns the name of the interface declared for i's stub when
+// accessor interfaces are enabled.
+func (i *Interface) AccessorName() string {
+	return i.ImplName() + "Accessor"
+}
 
+// HasRequired reports whether any of i's methods must have a stub.
+func (i *Interface) HasRequired() bool {
+	for j := range i.Funcs {
+		if i.Funcs[j].IsRequired() {
+			return true
 		}
-		p.Interfaces = append(p.Interfaces, &iface)
 	}
+	return false
 }
 
-type Interface struct {
-	Pkg                      *Package
-	Name, QualName, StubName string
-	Funcs                    []Func
+// TypeName returns the name of i's stub instantiated with its own type
+// parameters, e.g. "Container[T]", for use in method receivers.
+func (i *Interface) TypeName() string {
+	return i.ImplName() + i.TypeArgs()
 }
 
-func (i *Interface) ImplName() string {
-	return i.StubName
+// TypeParamsDecl returns i's type parameter list including constraints, e.g.
+// "[T fmt.Stringer]", or "" if i isn't generic.
+func (i *Interface) TypeParamsDecl() string {
+	if i.TypeParams.Len() == 0 {
+		return ""
+	}
+	parts := make([]string, i.TypeParams.Len())
+	for j := range parts {
+		tparam := i.TypeParams.At(j)
+		parts[j] = tparam.Obj().Name() + " " + i.typeString(tparam.Constraint())
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// TypeArgs returns i's type parameter names as a type argument list, e.g.
+// "[T]", or "" if i isn't generic.
+func (i *Interface) TypeArgs() string {
+	if i.TypeParams.Len() == 0 {
+		return ""
+	}
+	parts := make([]string, i.TypeParams.Len())
+	for j := range parts {
+		parts[j] = i.TypeParams.At(j).Obj().Name()
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// typeString renders t as it should appear in the stub's generated code.
+func (i *Interface) typeString(t types.Type) string {
+	s := types.TypeString(t, i.Pkg.importName)
+	if i.Pkg.Options.UseAny {
+		s = strings.Replace(s, "interface{}", "any", -1)
+	}
+	return s
 }
 
 type Func struct {
@@ -400,21 +2976,267 @@ type Func struct {
 	Name      string
 	Pkg       *types.Package
 	Signature *types.Signature
+	// Doc is the text of the method's doc comment in the interface, if it
+	// has one.
+	Doc string
 }
 
-func (f *Func) Qualifier(pkg *types.Package) string {
-	return pkg.Name()
+// DocComment returns f's doc comment from the interface as comment lines,
+// followed by an empty comment line to separate it from the rest of the
+// generated comment, or "" if the method has no doc comment.
+func (f *Func) DocComment() string {
+	if f.Doc == "" {
+		return ""
+	}
+	var buf strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(f.Doc, "\n"), "\n") {
+		if line == "" {
+			buf.WriteString("//\n")
+		} else {
+			buf.WriteString("// " + line + "\n")
+		}
+	}
+	buf.WriteString("//\n")
+	return buf.String()
 }
 
+// StubName returns the name of the field holding f's stub.
 func (f *Func) StubName() string {
-	return f.Name + "Stub"
+	return f.Interface.memberName(f.Name + ".Stub")
+}
+
+// StubType returns the type of f's stub field.
+func (f *Func) StubType() string {
+	if f.Interface.Pkg.Options.NamedFuncs {
+		return f.FuncTypeName() + f.Interface.TypeArgs()
+	}
+	return "func" + f.ParamsString() + " " + f.ResultsString()
+}
+
+// FuncTypeName returns the name of the func type declared for f when named
+// funcs are enabled. It includes the stub's name since several stubs in the
+// same package may share method names.
+func (f *Func) FuncTypeName() string {
+	return f.Interface.ImplName() + f.Name + "Func"
 }
 
+// CallsName returns the name of the accessor of f's calls, or of their field if
+// public isn't set.
 func (f *Func) CallsName(public bool) string {
 	if public {
-		return f.Name + "Calls"
+		return f.Interface.memberName(f.Name + ".Calls")
+	}
+	return f.Interface.memberName(f.Name + ".calls")
+}
+
+// CallTotalName returns the name of the field counting the calls made to f
+// when only the most recent Options.MaxCalls of them are kept.
+func (f *Func) CallTotalName() string {
+	return f.Interface.memberName(f.Name + ".callTotal")
+}
+
+// CallCountName returns the name of the helper returning f's number of calls.
+func (f *Func) CallCountName() string {
+	return f.Interface.memberName(f.Name + ".CallCount")
+}
+
+// CalledName returns the name of the helper reporting whether f was called.
+func (f *Func) CalledName() string {
+	return f.Interface.memberName(f.Name + ".Called")
+}
+
+// LastCallName returns the name of the helper returning f's most recent call.
+func (f *Func) LastCallName() string {
+	return f.Interface.memberName(f.Name + ".LastCall")
+}
+
+// CanMatch reports whether f should get helpers to find recorded calls that
+// match a predicate.
+func (f *Func) CanMatch() bool {
+	return f.Interface.Pkg.Options.Matchers && f.Signature.Params().Len() > 0
+}
+
+func (f *Func) FirstMatchingName() string {
+	return f.Interface.memberName(f.Name + ".FirstMatching")
+}
+
+// CanCountByArg reports whether f should get a helper that counts its calls
+// by their arguments.
+func (f *Func) CanCountByArg() bool {
+	return f.Interface.Pkg.Options.CountByArg && f.Signature.Params().Len() > 0
+}
+
+func (f *Func) CountByArgName() string {
+	return f.Interface.memberName(f.Name + ".CallCountByArg")
+}
+
+// CountKeyIsArg reports whether f's calls can be counted using its only
+// argument as the key. Interfaces are excluded, since their dynamic values
+// may not be comparable.
+func (f *Func) CountKeyIsArg() bool {
+	if f.Signature.Params().Len() != 1 {
+		return false
+	}
+	t := f.Signature.Params().At(0).Type()
+	return types.Comparable(t) && !types.IsInterface(t)
+}
+
+// CountKeyType returns the key type of the map returned by f's count by
+// argument helper.
+func (f *Func) CountKeyType() string {
+	if f.CountKeyIsArg() {
+		return f.typeString(f.Signature.Params().At(0).Type())
+	}
+	return "string"
+}
+
+// CountKey returns the key under which the recorded call named call is
+// counted.
+func (f *Func) CountKey(call string) string {
+	if f.CountKeyIsArg() {
+		return call + "." + f.paramFields()[0]
+	}
+	return `fmt.Sprintf("%+v", ` + call + ")"
+}
+
+// IsRequired reports whether f is listed in Options.Required.
+func (f *Func) IsRequired() bool {
+	for _, name := range f.Interface.Pkg.Options.Required {
+		if name == f.Interface.Name+"."+f.Name || name == f.Interface.QualName+"."+f.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// StubTag returns the struct tag of f's stub field, including a leading
+// space, or "" if it doesn't need one.
+func (f *Func) StubTag() string {
+	if f.IsRequired() {
+		return " `stubber:\"required\"`"
+	}
+	return ""
+}
+
+// AllowNilName returns the name of the field letting f run without a stub.
+func (f *Func) AllowNilName() string {
+	return f.Interface.memberName(f.Name + ".AllowNil")
+}
+
+// PanicsName returns the name of the field recording panics raised by f's stub.
+func (f *Func) PanicsName() string {
+	return f.Interface.memberName(f.Name + ".Panics")
+}
+
+// CallStoreName returns the name of the field that stores f's calls.
+func (f *Func) CallStoreName() string {
+	return f.Interface.memberName(f.Name + ".CallStore")
+}
+
+// CallCount returns an expression for the number of calls made to f, for use
+// after the current call has been recorded.
+func (f *Func) CallCount() string {
+	if f.Interface.Pkg.Options.CallStore {
+		return f.Receiver() + "." + f.CallStoreName() + ".Len()"
+	}
+	if f.Interface.Pkg.Options.MaxCalls > 0 {
+		// Only some of the calls are kept, so they're counted separately.
+		if f.Interface.Pkg.Options.Concurrent {
+			return f.Receiver() + "." + f.CallCountName() + "()"
+		}
+		return f.Receiver() + "." + f.CallTotalName()
+	}
+	if f.Interface.Pkg.Options.Concurrent {
+		// Go through the accessor, which holds the lock.
+		return "len(" + f.Receiver() + "." + f.CallsName(true) + "())"
+	}
+	return "len(" + f.Receiver() + "." + f.CallsName(false) + ")"
+}
+
+// Uncalled returns a condition that is true if no calls to f have been
+// recorded.
+func (f *Func) Uncalled() string {
+	if f.Interface.Pkg.Options.CallStore {
+		store := f.Receiver() + "." + f.CallStoreName()
+		return "(" + store + " == nil || " + store + ".Len() == 0)"
+	}
+	return "len(" + f.Receiver() + "." + f.CallsName(false) + ") == 0"
+}
+
+// LastCall returns an expression for the parameters of the current call to
+// f, for use after it has been recorded. A call store may not keep the calls
+// it records, concurrent calls may have been recorded since, a ring buffer
+// of calls doesn't keep the most recent one last, and a call whose results
+// are recorded isn't recorded until it returns, so in those cases the
+// parameters are collected again.
+func (f *Func) LastCall() string {
+	if opts := f.Interface.Pkg.Options; opts.CallStore || opts.Concurrent || opts.ValueReceiver || opts.MaxCalls > 0 || f.RecordsReturns() {
+		return f.ParamsStruct() + "{" + f.ParamsStructValues() + "}"
+	}
+	calls := f.Receiver() + "." + f.CallsName(false)
+	return calls + "[len(" + calls + ")-1]"
+}
+
+// NilStubMessage returns an expression for the message of the panic raised
+// when f is called without a stub, which includes the call's arguments if
+// Options.VerbosePanic is set.
+func (f *Func) NilStubMessage() string {
+	name := f.Interface.ImplName() + "." + f.Name
+	params := f.Signature.Params()
+	if !f.Interface.Pkg.Options.VerbosePanic || params.Len() == 0 {
+		return strconv.Quote(name + ": nil method stub")
+	}
+	verbs := make([]string, params.Len())
+	var args []string
+	for i := range verbs {
+		switch params.At(i).Type().Underlying().(type) {
+		case *types.Signature:
+			verbs[i] = "<func>"
+		case *types.Chan:
+			verbs[i] = "<chan>"
+		default:
+			verbs[i] = "%v"
+			args = append(args, f.paramName(i))
+		}
+	}
+	format := strconv.Quote(name + "(" + strings.Join(verbs, ", ") + "): nil method stub")
+	if len(args) == 0 {
+		return format
+	}
+	return "fmt.Sprintf(" + format + ", " + strings.Join(args, ", ") + ")"
+}
+
+// Receiver returns the name of the receiver variable in f's methods.
+func (f *Func) Receiver() string {
+	return f.Interface.Pkg.Options.ReceiverName
+}
+
+// paramName returns the name of f's i'th parameter, renamed if necessary so
+// that it doesn't shadow an imported package or the receiver.
+func (f *Func) paramName(i int) string {
+	name := ensureNoCollision(f.sourceParamName(i), f.Interface.Pkg.DependencyNames)
+	for name == f.Receiver() {
+		name = ensureNoCollision("_"+name, f.Interface.Pkg.DependencyNames)
+	}
+	return name
+}
+
+// sourceParamName returns the name of f's parameter i as declared by the
+// interface. Unnamed and blank parameters can't be referred to, so they're
+// named after their position instead, e.g. arg0.
+func (f *Func) sourceParamName(i int) string {
+	params := f.Signature.Params()
+	if name := params.At(i).Name(); name != "" && name != "_" {
+		return name
 	}
-	return string(unicode.ToLower(rune(f.Name[0]))) + f.Name[1:] + "Calls"
+	name := "arg" + strconv.Itoa(i)
+	for j := 0; j < params.Len(); j++ {
+		if params.At(j).Name() == name {
+			name += "_"
+			j = -1
+		}
+	}
+	return name
 }
 
 func ensureNoCollision(name string, depNames map[string]struct{}) string {
@@ -426,15 +3248,20 @@ func ensureNoCollision(name string, depNames map[string]struct{}) string {
 	}
 }
 
+// typeString renders t as it should appear in f's generated code.
+func (f *Func) typeString(t types.Type) string {
+	return f.Interface.typeString(t)
+}
+
 func (f *Func) ParamsString() string {
 	params := make([]string, f.Signature.Params().Len())
 	for i := 0; i < len(params); i++ {
 		v := f.Signature.Params().At(i)
-		name := ensureNoCollision(v.Name(), f.Interface.Pkg.DependencyNames)
-		typeString := types.TypeString(v.Type(), f.Qualifier)
+		name := f.paramName(i)
+		typeString := f.typeString(v.Type())
 		if f.Signature.Variadic() && i == len(params)-1 {
 			if slice, ok := v.Type().(*types.Slice); ok {
-				typeString = "..." + types.TypeString(slice.Elem(), f.Qualifier)
+				typeString = "..." + f.typeString(slice.Elem())
 			}
 		}
 		params[i] = name + " " + typeString
@@ -443,43 +3270,347 @@ func (f *Func) ParamsString() string {
 }
 
 func (f *Func) ParamsStruct() string {
-	parts := make([]string, f.Signature.Params().Len())
-	for i := 0; i < len(parts); i++ {
-		param := f.Signature.Params().At(i)
-		name := ensureNoCollision(publicize(param.Name()), f.Interface.Pkg.DependencyNames)
-		typeString := types.TypeString(param.Type(), f.Qualifier)
-		parts[i] = name + " " + typeString
+	names := f.paramFields()
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + " " + f.typeString(f.Signature.Params().At(i).Type())
+	}
+	if f.RecordsReturns() {
+		for i, name := range f.resultFields() {
+			parts = append(parts, name+" "+f.typeString(f.Signature.Results().At(i).Type()))
+		}
 	}
 	return "struct{" + strings.Join(parts, ";") + "}"
 }
 
 func (f *Func) ParamsStructValues() string {
 	var buf bytes.Buffer
-	for i := 0; i < f.Signature.Params().Len(); i++ {
-		valueName := f.Signature.Params().At(i).Name()
-		keyName := publicize(valueName)
-		buf.WriteString(ensureNoCollision(keyName, f.Interface.Pkg.DependencyNames) + ": " + ensureNoCollision(valueName, f.Interface.Pkg.DependencyNames) + ",")
+	for i, name := range f.paramFields() {
+		buf.WriteString(name + ": " + f.paramName(i) + ",")
 	}
 	return buf.String()
 }
 
+// paramFields returns the names of the fields recording each of f's
+// parameters. Parameter names that differ only in the case of their first
+// letter, e.g. key and Key, would map to the same field, so later ones are
+// numbered to keep them distinct.
+func (f *Func) paramFields() []string {
+	names := make([]string, f.Signature.Params().Len())
+	seen := make(map[string]bool)
+	for i := range names {
+		base := ensureNoCollision(publicize(f.sourceParamName(i)), f.Interface.Pkg.DependencyNames)
+		name := base
+		for n := 2; seen[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// resultFields returns the names of the fields recording each of f's results,
+// which are named after the results, or R0, R1 and so on if they're unnamed.
+// They're numbered like parameter fields to keep them distinct.
+func (f *Func) resultFields() []string {
+	seen := make(map[string]bool)
+	for _, name := range f.paramFields() {
+		seen[name] = true
+	}
+	results := f.Signature.Results()
+	names := make([]string, results.Len())
+	for i := range names {
+		base := "R" + strconv.Itoa(i)
+		if name := results.At(i).Name(); name != "" && name != "_" {
+			base = publicize(name)
+		}
+		name := base
+		for n := 2; seen[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// ParamNames returns f's parameter names for forwarding its arguments to
+// another function, expanding the variadic parameter if there is one.
 func (f *Func) ParamNames() string {
 	var parts []string
 	for i := 0; i < f.Signature.Params().Len(); i++ {
-		name := ensureNoCollision(f.Signature.Params().At(i).Name(), f.Interface.Pkg.DependencyNames)
-		parts = append(parts, name)
+		parts = append(parts, f.paramName(i))
+	}
+	if f.Signature.Variadic() {
+		parts[len(parts)-1] += "..."
 	}
 	return strings.Join(parts, ", ")
 }
 
+// ResultsString returns the result types of f. Result names aren't
+// preserved, so a single result is never parenthesized.
 func (f *Func) ResultsString() string {
-	return types.TypeString(f.Signature.Results(), f.Qualifier)
+	results := f.Signature.Results()
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = f.typeString(results.At(i).Type())
+	}
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	default:
+		return "(" + strings.Join(parts, ", ") + ")"
+	}
+}
+
+// BlankResultsString returns the results of f with each one named "_", so
+// that a function literal with that signature can use a bare return.
+func (f *Func) BlankResultsString() string {
+	results := f.Signature.Results()
+	if results.Len() == 0 {
+		return ""
+	}
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = "_ " + f.typeString(results.At(i).Type())
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// ZeroResults returns the zero values of f's results, separated by commas.
+func (f *Func) ZeroResults() string {
+	return strings.Join(f.zeroResults(), ", ")
+}
+
+func (f *Func) zeroResults() []string {
+	parts := make([]string, f.Signature.Results().Len())
+	for i := range parts {
+		parts[i] = zeroValue(f.Signature.Results().At(i).Type(), f.typeString)
+	}
+	return parts
+}
+
+// ReturnsError reports whether f's last result is an error.
+func (f *Func) ReturnsError() bool {
+	results := f.Signature.Results()
+	return results.Len() > 0 && types.Identical(results.At(results.Len()-1).Type(), errorType)
+}
+
+// ErrorResults returns the zero values of f's results, except that the final
+// error is the expression err.
+func (f *Func) ErrorResults(err string) string {
+	parts := f.zeroResults()
+	parts[len(parts)-1] = err
+	return strings.Join(parts, ", ")
+}
+
+// CanFail reports whether f should get fields to make it fail after a
+// number of calls.
+func (f *Func) CanFail() bool {
+	return f.Interface.Pkg.Options.FailAfter && f.ReturnsError()
+}
+
+func (f *Func) FailAfterName() string {
+	return f.Interface.memberName(f.Name + ".FailAfter")
+}
+
+func (f *Func) FailErrorName() string {
+	return f.Interface.memberName(f.Name + ".FailError")
+}
+
+// CanValidate reports whether f should get a field to validate its
+// arguments.
+func (f *Func) CanValidate() bool {
+	return f.Interface.Pkg.Options.Validate && f.Signature.Params().Len() > 0
+}
+
+func (f *Func) ValidateName() string {
+	return f.Interface.memberName(f.Name + ".Validate")
 }
 
 func (f *Func) HasResults() bool {
 	return f.Signature.Results().Len() != 0
 }
 
+// CanReturn reports whether f should get a field holding a queue of results
+// to return.
+func (f *Func) CanReturn() bool {
+	return f.Interface.Pkg.Options.Returns && f.HasResults()
+}
+
+func (f *Func) ReturnsName() string {
+	return f.Interface.memberName(f.Name + ".Returns")
+}
+
+// NextReturnsName returns the name of the method that removes the first of
+// f's queued results.
+func (f *Func) NextReturnsName() string {
+	return f.Interface.memberName(f.Name + ".nextReturns")
+}
+
+// NextName returns the name of the variable holding the queued results
+// returned by a call to f.
+func (f *Func) NextName() string {
+	return f.localName("next")
+}
+
+// localName returns name, renamed if necessary so that a variable with that
+// name in f's method doesn't collide with its parameters or receiver.
+func (f *Func) localName(name string) string {
+	name = f.Interface.Pkg.Local(name)
+	for i := 0; i < f.Signature.Params().Len(); i++ {
+		if f.paramName(i) == name {
+			name = f.Interface.Pkg.Local(name + "_")
+			i = -1
+		}
+	}
+	return name
+}
+
+// RecordsReturns reports whether f's recorded calls include its results.
+func (f *Func) RecordsReturns() bool {
+	return f.Interface.Pkg.Options.RecordReturns && f.HasResults()
+}
+
+// RecoveredName returns the name of the variable holding a panic recovered
+// from f's stub.
+func (f *Func) RecoveredName() string {
+	return f.localName("recovered")
+}
+
+// resultName returns the name given to f's i'th result in its method when its
+// results are recorded.
+func (f *Func) resultName(i int) string {
+	return f.localName("r" + strconv.Itoa(i))
+}
+
+// MethodResultsString returns the results of f's method. They're named when
+// its results are recorded, so that a deferred function can record them
+// whichever way the method returns.
+func (f *Func) MethodResultsString() string {
+	if !f.RecordsReturns() {
+		return f.ResultsString()
+	}
+	results := f.Signature.Results()
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = f.resultName(i) + " " + f.typeString(results.At(i).Type())
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// RecordedCall returns a composite literal of the call recorded when f's
+// method returns, holding its parameters and named results.
+func (f *Func) RecordedCall() string {
+	var buf bytes.Buffer
+	buf.WriteString(f.ParamsStruct() + "{" + f.ParamsStructValues())
+	for i, field := range f.resultFields() {
+		buf.WriteString(field + ": " + f.resultName(i) + ",")
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// ResultsStruct returns a struct type with a field for each of f's results,
+// named R0, R1 and so on.
+func (f *Func) ResultsStruct() string {
+	results := f.Signature.Results()
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = "R" + strconv.Itoa(i) + " " + f.typeString(results.At(i).Type())
+	}
+	return "struct{" + strings.Join(parts, ";") + "}"
+}
+
+// NextResults returns the fields of the queued results returned by a call to
+// f, separated by commas.
+func (f *Func) NextResults() string {
+	return f.StructResults(f.NextName())
+}
+
+// StructResults returns the fields of name, a value of f's ResultsStruct,
+// separated by commas.
+func (f *Func) StructResults(name string) string {
+	results := f.Signature.Results()
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = name + ".R" + strconv.Itoa(i)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ExpectedName returns the name of the recorder field holding the calls
+// expected of f.
+func (f *Func) ExpectedName() string {
+	return "expected" + f.Name
+}
+
+// CallTypeName returns the name of the type of an expected call to f.
+func (f *Func) CallTypeName() string {
+	return f.Interface.ImplName() + f.Name + "Call"
+}
+
+// ResultsVarName returns the name of the variable holding the results of an
+// expected call to f.
+func (f *Func) ResultsVarName() string {
+	return f.localName("results")
+}
+
+// ExpectParams returns the parameters of the recorder method expecting a
+// call to f, each of which is a value or a support.Matcher.
+func (f *Func) ExpectParams() string {
+	params := make([]string, f.Signature.Params().Len())
+	for i := range params {
+		params[i] = f.paramName(i) + " interface{}"
+	}
+	if f.Signature.Variadic() {
+		params[len(params)-1] = f.paramName(len(params)-1) + " ...interface{}"
+	}
+	return strings.Join(params, ", ")
+}
+
+// ExpectArgs returns f's parameter names as arguments for an expected call,
+// with the elements of a variadic parameter as separate arguments. If typed
+// is set, the parameters are those of f itself rather than ExpectParams.
+func (f *Func) ExpectArgs(typed bool) string {
+	var args []string
+	for i := 0; i < f.Signature.Params().Len(); i++ {
+		args = append(args, f.paramName(i))
+	}
+	if !f.Signature.Variadic() {
+		return strings.Join(args, ", ")
+	}
+	last := args[len(args)-1]
+	if typed {
+		last = "support.Spread(" + last + ")"
+	}
+	return "append([]interface{}{" + strings.Join(args[:len(args)-1], ", ") + "}, " + last + "...)..."
+}
+
+// ReturnParams returns the parameter list of the method setting the results
+// of an expected call to f.
+func (f *Func) ReturnParams() string {
+	results := f.Signature.Results()
+	parts := make([]string, results.Len())
+	for i := range parts {
+		parts[i] = "r" + strconv.Itoa(i) + " " + f.typeString(results.At(i).Type())
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// ReturnParamNames returns the names of the parameters in ReturnParams,
+// separated by commas.
+func (f *Func) ReturnParamNames() string {
+	parts := make([]string, f.Signature.Results().Len())
+	for i := range parts {
+		parts[i] = "r" + strconv.Itoa(i)
+	}
+	return strings.Join(parts, ", ")
+}
+
 func publicize(name string) string {
 	if len(name) == 0 {
 		panic("empty name found, make sure all your interface parameters have a name!")
@@ -494,14 +3625,30 @@ func publicize(name string) string {
 	}
 }
 
-// indirect returns the type that t points to. If it's not a pointer it
-// returns its argument.
-func indirect(t types.Type) types.Type {
-	for {
-		ptype, ok := t.(*types.Pointer)
-		if !ok {
-			return t
+var errorType = types.Universe.Lookup("error").Type()
+
+// zeroValue returns an expression for the zero value of t, using typeString
+// to render composite types.
+func zeroValue(t types.Type, typeString func(types.Type) string) string {
+	if _, ok := t.(*types.TypeParam); ok {
+		// The zero value of a type parameter has no literal form.
+		return "*new(" + typeString(t) + ")"
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		default:
+			return "nil"
 		}
-		t = ptype.Elem()
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return "nil"
+	default:
+		return typeString(t) + "{}"
 	}
 }
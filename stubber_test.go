@@ -2,10 +2,15 @@ package main_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"io/ioutil"
+	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -21,24 +26,862 @@ func init() {
 	flag.Parse()
 }
 
+var stubberTests = []struct {
+	name      string
+	inputDir  string
+	outputDir string
+	opts      main.Options
+	// goldens lists the expected output files in the order they're written.
+	// It defaults to the stubs for inputDir in outputDir.
+	goldens []string
+}{
+	{"default", "./testdata/bank", "./testdata/stubs", main.Options{}, nil},
+	{"pkgpath", "./testdata/bank", "./testdata/stubs", main.Options{PkgPath: "github.com/dradtke/stubber/testdata/bank"}, nil},
+	{"tests", "./testdata/clock", "./testdata/clock", main.Options{Tests: true, Filename: "{{.Name}}_stubs_test.go"}, []string{"./testdata/clock/clock_stubs_test.go"}},
+	{"importpath", "sort", "./testdata/stubs", main.Options{}, nil},
+	{"inpackage", "./testdata/bank", "./testdata/bank", main.Options{}, nil},
+	{"file", "./testdata/bank/account.go", "./testdata/stubs", main.Options{}, []string{"./testdata/stubs/bank_stubs.go"}},
+	{"ledger", "./testdata/ledger", "./testdata/stubs", main.Options{}, nil},
+	{"logger", "./testdata/logger", "./testdata/stubs", main.Options{}, nil},
+	{"container", "./testdata/container", "./testdata/stubs", main.Options{}, nil},
+	{"multifile", "./testdata/multifile", "./testdata/stubs", main.Options{}, nil},
+	{"builtins", "./testdata/builtins", "./testdata/stubs", main.Options{}, nil},
+	{"statfs", "./testdata/statfs", "./testdata/stubs", main.Options{}, nil},
+	{"selfstubs", "./testdata/selfstubs", "./testdata/stubs", main.Options{}, nil},
+	{"tree", "./testdata/tree", "./testdata/stubs", main.Options{}, nil},
+	{"rpc", "./testdata/rpc", "./testdata/stubs", main.Options{}, nil},
+	{"router", "./testdata/router", "./testdata/stubs", main.Options{}, nil},
+	{"endpoint", "./testdata/endpoint", "./testdata/stubs", main.Options{}, nil},
+	{"alias", "./testdata/alias", "./testdata/stubs", main.Options{}, nil},
+	{"loader", "./testdata/loader", "./testdata/stubs", main.Options{}, nil},
+	{"clash", "./testdata/ledgerclash", "./testdata/clash", main.Options{CallLog: true, Matchers: true, CountByArg: true, Returns: true}, nil},
+	{"clashfixed", "./testdata/ledgerclash", "./testdata/clashfixed", main.Options{Marshal: true, Clone: true, Required: []string{"Statement.Balance"}, Sink: true, Unexpected: main.UnexpectedFail, AssertUsed: true}, nil},
+	{"stubsuffix", "./testdata/bank", "./testdata/stubsuffix", main.Options{StubSuffix: "Func"}, nil},
+	// The stub fields give way to the accessors of the same name.
+	{"stubsuffixclash", "./testdata/bank", "./testdata/stubsuffixclash", main.Options{StubSuffix: "Calls"}, nil},
+	{"pattern", "./testdata/nested/...", "./testdata/nestedstubs", main.Options{}, []string{"./testdata/nestedstubs/a/a_stubs.go", "./testdata/nestedstubs/b/c/c_stubs.go"}},
+	{"users", "./testdata/users", "./testdata/stubs", main.Options{}, nil},
+	{"lookup", "./testdata/lookup", "./testdata/stubs", main.Options{}, nil},
+	{"versioned", "./testdata/versioned", "./testdata/stubs", main.Options{}, nil},
+	{"unnamed", "./testdata/unnamed", "./testdata/stubs", main.Options{}, nil},
+	{"stream", "./testdata/stream", "./testdata/stubs", main.Options{}, nil},
+	{"ignore", "./testdata/ignore", "./testdata/stubs", main.Options{}, nil},
+	{"methodsof", "./testdata/concrete", "./testdata/stubs", main.Options{MethodsOf: []string{"Mailer"}}, nil},
+	{"repo", "./testdata/repo", "./testdata/stubs", main.Options{}, nil},
+	{"dupnames", "./testdata/dupnames", "./testdata/stubs", main.Options{}, nil},
+	{"locker", "./testdata/locker", "./testdata/stubs", main.Options{}, nil},
+	{"generic", "./testdata/generic", "./testdata/stubs", main.Options{}, nil},
+	{"receivername", "./testdata/bank", "./testdata/receivername", main.Options{ReceiverName: "stub"}, nil},
+	{"receiverclash", "./testdata/bank", "./testdata/receiverclash", main.Options{ReceiverName: "tb", Verify: true, AssertUsed: true, CallLog: true, Marshal: true}, nil},
+	{"receivercalls", "./testdata/bank", "./testdata/receivercalls", main.Options{ReceiverName: "calls", MaxCalls: 2, Matchers: true, CountByArg: true, Returns: true}, nil},
+	{"failafter", "./testdata/bank", "./testdata/failafter", main.Options{FailAfter: true, Returns: true, Validate: true}, nil},
+	{"regions", "./testdata/bank", "./testdata/regions", main.Options{Regions: true}, nil},
+	{"namedfuncs", "./testdata/bank", "./testdata/namedfuncs", main.Options{NamedFuncs: true}, nil},
+	{"validate", "./testdata/bank", "./testdata/validate", main.Options{Validate: true}, nil},
+	{"sink", "./testdata/bank", "./testdata/sink", main.Options{Sink: true}, nil},
+	{"calllog", "./testdata/bank", "./testdata/calllog", main.Options{CallLog: true}, nil},
+	{"accessoriface", "./testdata/bank", "./testdata/accessoriface", main.Options{AccessorIface: true, CallLog: true}, nil},
+	{"callstore", "./testdata/bank", "./testdata/callstore", main.Options{CallStore: true, FailAfter: true, Sink: true}, nil},
+	{"marshal", "./testdata/bank", "./testdata/marshal", main.Options{Marshal: true}, nil},
+	{"panictoggles", "./testdata/bank", "./testdata/panictoggles", main.Options{PanicToggles: true, Clone: true}, nil},
+	{"recoverpanics", "./testdata/bank", "./testdata/recoverpanics", main.Options{RecoverPanics: true}, nil},
+	{"spy", "./testdata/bank", "./testdata/spy", main.Options{Spy: true, Clone: true}, nil},
+	{"delegate", "./testdata/bank", "./testdata/delegate", main.Options{Delegate: true}, nil},
+	{"maxcalls", "./testdata/bank", "./testdata/maxcalls", main.Options{MaxCalls: 3, FailAfter: true}, nil},
+	{"matchers", "./testdata/bank", "./testdata/matchers", main.Options{Matchers: true}, nil},
+	{"assertused", "./testdata/bank", "./testdata/assertused", main.Options{AssertUsed: true}, nil},
+	{"verify", "./testdata/bank", "./testdata/verify", main.Options{Verify: true}, nil},
+	{"countbyarg", "./testdata/bank", "./testdata/countbyarg", main.Options{CountByArg: true}, nil},
+	{"testonly", "./testdata/bank", "./testdata/testonly", main.Options{TestOnly: true}, nil},
+	{"required", "./testdata/bank", "./testdata/required", main.Options{Required: []string{"Account.Balance", "bank.WithdrawableAccount.Withdraw"}}, nil},
+	{"examples", "./testdata/bank", "./testdata/examples", main.Options{Examples: true}, nil},
+	{"concurrent", "./testdata/bank", "./testdata/concurrent", main.Options{Concurrent: true, CallLog: true}, nil},
+	{"returns", "./testdata/bank", "./testdata/returns", main.Options{Returns: true}, nil},
+	{"recordreturns", "./testdata/search", "./testdata/recordreturns", main.Options{RecordReturns: true}, nil},
+	{"gomock", "./testdata/bank", "./testdata/gomock", main.Options{Gomock: true}, nil},
+	{"verbosepanic", "./testdata/bank", "./testdata/verbosepanic", main.Options{VerbosePanic: true}, nil},
+	{"prefix", "./testdata/bank", "./testdata/prefix", main.Options{Prefix: "Fake", Suffix: "Mock"}, nil},
+	{"valuereceiver", "./testdata/bank", "./testdata/valuereceiver", main.Options{ValueReceiver: true}, nil},
+	{"clone", "./testdata/bank", "./testdata/clone", main.Options{Clone: true}, nil},
+	{"unexpected", "./testdata/bank", "./testdata/unexpected", main.Options{Unexpected: main.UnexpectedFail}, nil},
+	{"warn", "./testdata/bank", "./testdata/warn", main.Options{Unexpected: main.UnexpectedWarn}, nil},
+	{"toggle", "./testdata/bank", "./testdata/toggle", main.Options{Unexpected: main.UnexpectedToggle}, []string{
+		"./testdata/toggle/bank_stubs.go",
+		"./testdata/toggle/panic_on_nil_stub.go",
+	}},
+	{"zerovalue", "./testdata/bank", "./testdata/zerovalue", main.Options{ZeroValue: main.ZeroValueNoop}, nil},
+	{"outputmap", "./testdata/bank", "./testdata/outputmap/account", main.Options{
+		OutputMap: map[string]string{"bank.WithdrawableAccount": "./testdata/outputmap/withdrawable"},
+	}, []string{
+		"./testdata/outputmap/account/bank_stubs.go",
+		"./testdata/outputmap/withdrawable/bank_stubs.go",
+	}},
+	{"vendored", "./testdata/vendored/api", "./testdata/vendored/mocks", main.Options{}, nil},
+	{"workspace", "./testdata/workspace/source", "./testdata/workspace/mocks", main.Options{}, nil},
+	{"testify", "./testdata/testify/source", "./testdata/testify/mocks", main.Options{Style: main.TestifyStyle}, nil},
+}
+
 func TestStubber(t *testing.T) {
+	for _, tt := range stubberTests {
+		t.Run(tt.name, func(t *testing.T) {
+			goldens := tt.goldens
+			if goldens == nil {
+				goldens = []string{filepath.Join(tt.outputDir, filepath.Base(tt.inputDir)+"_stubs.go")}
+			}
+
+			if update {
+				main.Main(nil, []string{tt.inputDir}, tt.outputDir, nil, nil, tt.opts)
+				for _, golden := range goldens {
+					// Build from within the output directory, which may belong
+					// to a different module. The test_mocks tag is needed by
+					// stubs generated with TestOnly.
+					cmd := exec.Command("go", "build", "-tags", "test_mocks", "-o", os.DevNull, ".")
+					cmd.Dir = filepath.Dir(golden)
+					if v, err := cmd.CombinedOutput(); err != nil {
+						t.Errorf("new golden file failed to build:\n%s", string(v))
+					}
+				}
+				return
+			}
+
+			var buf bytes.Buffer
+			main.Main(nil, []string{tt.inputDir}, tt.outputDir, &buf, nil, tt.opts)
+
+			var expected []byte
+			for _, golden := range goldens {
+				b, err := ioutil.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected = append(expected, b...)
+			}
+
+			if diff := cmp.Diff(string(expected), buf.String()); diff != "" {
+				t.Errorf("output mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUseAny(t *testing.T) {
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./testdata/logger"}, "", &buf, nil, main.Options{UseAny: true})
+
+	got := buf.String()
+	if strings.Contains(got, "interface{}") {
+		t.Errorf("empty interface was not rendered as any:\n%s", got)
+	}
+	if !strings.Contains(got, "func (s *Logger) Printf(format string, args ...any)") {
+		t.Errorf("variadic any parameter was not rendered correctly:\n%s", got)
+	}
+}
+
+func TestDeterministicImports(t *testing.T) {
+	opts := main.Options{Concurrent: true, CallLog: true, VerbosePanic: true}
+	generate := func() string {
+		var buf bytes.Buffer
+		main.Main(nil, []string{"./testdata/versioned"}, "", &buf, nil, opts)
+		return buf.String()
+	}
+
+	first := generate()
+	for i := 0; i < 5; i++ {
+		if diff := cmp.Diff(first, generate()); diff != "" {
+			t.Fatalf("output changed between runs (-first +later):\n%s", diff)
+		}
+	}
+}
+
+func TestTypeFilters(t *testing.T) {
+	tests := []struct {
+		name  string
+		types []string
+		opts  main.Options
+		want  []string
+	}{
+		{"all", nil, main.Options{}, []string{"Account", "WithdrawableAccount"}},
+		{"names", []string{"Account"}, main.Options{}, []string{"Account"}},
+		{"regexp", nil, main.Options{TypesRegexp: regexp.MustCompile("^With")}, []string{"WithdrawableAccount"}},
+		{"names or regexp", []string{"Account"}, main.Options{TypesRegexp: regexp.MustCompile("^With")}, []string{"Account", "WithdrawableAccount"}},
+		{"exclude", nil, main.Options{Exclude: []string{"Account"}}, []string{"WithdrawableAccount"}},
+		{"exclude over names", []string{"Account", "WithdrawableAccount"}, main.Options{Exclude: []string{"WithdrawableAccount"}}, []string{"Account"}},
+		{"exclude over regexp", nil, main.Options{TypesRegexp: regexp.MustCompile("Account$"), Exclude: []string{"Account"}}, []string{"WithdrawableAccount"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			main.Main(tt.types, []string{"./testdata/bank"}, "", &buf, nil, tt.opts)
+			var got []string
+			for _, m := range regexp.MustCompile(`(?m)^type (\w+) struct`).FindAllStringSubmatch(buf.String(), -1) {
+				got = append(got, m[1])
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("stubbed types mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScaffold(t *testing.T) {
+	data, err := ioutil.ReadFile("./testdata/recordings/bank.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var opts main.Options
+	if err := json.Unmarshal(data, &opts.Recordings); err != nil {
+		t.Fatal(err)
+	}
+
 	if update {
-		main.Main(nil, []string{"./testdata/bank"}, "./testdata/stubs", nil, nil)
-		if v, err := exec.Command("go", "build", "-o", os.DevNull, "./testdata/stubs").CombinedOutput(); err != nil {
+		os.Remove("./testdata/stubs/bank_stubs_test.go")
+		main.Main(nil, []string{"./testdata/bank"}, "./testdata/stubs", nil, nil, opts)
+		if v, err := exec.Command("go", "vet", "./testdata/stubs").CombinedOutput(); err != nil {
 			t.Errorf("new golden file failed to build:\n%s", string(v))
 		}
 		return
 	}
 
 	var buf bytes.Buffer
-	main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil)
+	main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil, opts)
+
+	var expected []byte
+	for _, name := range []string{"./testdata/stubs/bank_stubs.go", "./testdata/stubs/bank_stubs_test.go"} {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, b...)
+	}
 
-	expected, err := ioutil.ReadFile("./testdata/stubs/bank_stubs.go")
+	if diff := cmp.Diff(string(expected), buf.String()); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestScaffoldInPackage(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":   "module example.com/store\n\ngo 1.18\n",
+		"store.go": "package store\n\ntype Key string\n\ntype Store interface {\n\tGet(key Key) string\n}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The test is written into the package declaring Key, so it mustn't
+	// import it.
+	opts := main.Options{Recordings: []main.Recording{{
+		Interface: "store.Store",
+		Calls:     []main.RecordedCall{{Method: "Get", Args: json.RawMessage(`{"Key":"a"}`)}},
+	}}}
+	main.Main(nil, []string{dir}, dir, nil, nil, opts)
+	cmd := exec.Command("go", "vet", ".")
+	cmd.Dir = dir
+	if v, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("scaffolded test failed to build:\n%s", string(v))
+	}
+}
+
+func TestScaffoldImportNames(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":             "module example.com/store\n\ngo 1.18\n",
+		"store.go":           "package store\n\nimport (\n\t\"example.com/store/json\"\n\t\"example.com/store/testing\"\n)\n\ntype Store interface {\n\tPut(v json.Value, c testing.Case)\n}\n",
+		"json/json.go":       "package json\n\ntype Value string\n",
+		"testing/testing.go": "package testing\n\ntype Case string\n",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The stubs import the packages named json and testing, so the test's
+	// own imports of encoding/json and testing must be aliased.
+	opts := main.Options{Recordings: []main.Recording{{
+		Interface: "store.Store",
+		Calls:     []main.RecordedCall{{Method: "Put", Args: json.RawMessage(`{"V":"a","C":"b"}`)}},
+	}}}
+	main.Main(nil, []string{dir}, filepath.Join(dir, "stubs"), nil, nil, opts)
+	cmd := exec.Command("go", "vet", "./stubs")
+	cmd.Dir = dir
+	if v, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("scaffolded test failed to build:\n%s", string(v))
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	filename, err := filepath.Abs("./testdata/bank/account.go")
 	if err != nil {
 		t.Fatal(err)
 	}
+	opts := main.Options{
+		Overlay: map[string][]byte{
+			filename: []byte("package bank\n\ntype Vault interface {\n\tOpen(code string) error\n}\n"),
+		},
+	}
+
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil, opts)
+
+	if got := buf.String(); !strings.Contains(got, "type Vault struct") || strings.Contains(got, "type Account struct") {
+		t.Errorf("stubs were not generated from the overlay:\n%s", got)
+	}
+}
+
+func TestStyle(t *testing.T) {
+	main.RegisterGenerator("names", main.GeneratorFunc(func(p *main.Package) ([]byte, error) {
+		var src strings.Builder
+		src.WriteString("package " + p.OutputName + "\n\n")
+		for _, iface := range p.Interfaces {
+			src.WriteString("// " + iface.ImplName() + "\n")
+		}
+		return []byte(src.String()), nil
+	}))
 
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil, main.Options{Style: "names"})
+
+	if got, want := buf.String(), "package stubs\n\n// Account\n// WithdrawableAccount\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	outputDir := filepath.Join(t.TempDir(), "stubs")
+	main.Main(nil, []string{"./testdata/bank"}, outputDir, nil, nil, main.Options{DryRun: true})
+
+	if _, err := os.Stat(outputDir); !os.IsNotExist(err) {
+		t.Errorf("output directory was created: %v", err)
+	}
+	if msg := "would write " + filepath.Join(outputDir, "bank_stubs.go"); !strings.Contains(logs.String(), msg) {
+		t.Errorf("missing %q in:\n%s", msg, logs.String())
+	}
+}
+
+func TestFilename(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	outputDir := filepath.Join(t.TempDir(), "stubs")
+	main.Main(nil, []string{"./testdata/bank"}, outputDir, nil, nil, main.Options{Filename: "{{.Name}}_fakes.go", DryRun: true})
+
+	if msg := "would write " + filepath.Join(outputDir, "bank_fakes.go"); !strings.Contains(logs.String(), msg) {
+		t.Errorf("missing %q in:\n%s", msg, logs.String())
+	}
+}
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		opts main.Options
+		want string
+	}{
+		{"default", main.Options{}, "// This file was generated by stubber; DO NOT EDIT\n\n//go:build !nostubs\n// +build !nostubs\n\npackage stubs\n"},
+		{"custom", main.Options{Comment: "Code generated by stubber. DO NOT EDIT.\nSee tools.go.", BuildTag: "integration && !nostubs"}, "// Code generated by stubber. DO NOT EDIT.\n// See tools.go.\n\n//go:build integration && !nostubs\n// +build integration,!nostubs\n\npackage stubs\n"},
+		{"none", main.Options{BuildTag: main.NoBuildTag}, "// This file was generated by stubber; DO NOT EDIT\n\npackage stubs\n"},
+		{"testonly", main.Options{BuildTag: main.NoBuildTag, TestOnly: true}, "// This file was generated by stubber; DO NOT EDIT\n\n//go:build test_mocks\n// +build test_mocks\n\npackage stubs\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil, tt.opts)
+			if got := buf.String(); !strings.HasPrefix(got, tt.want) {
+				t.Errorf("header mismatch, want prefix:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildTagReload(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":   "module example.com/store\n\ngo 1.18\n",
+		"store.go": "package store\n\ntype Store interface {\n\tGet(key string) string\n}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The accessor interface declared in the stubs would be stubbed too if
+	// they were loaded again.
+	opts := main.Options{BuildTag: "!nomocks", Filename: "store_mocks.go", AccessorIface: true}
+	main.Main(nil, []string{dir}, dir, nil, nil, opts)
+	first, err := ioutil.ReadFile(filepath.Join(dir, "store_mocks.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.Main(nil, []string{dir}, dir, nil, nil, opts)
+	second, err := ioutil.ReadFile(filepath.Join(dir, "store_mocks.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(first), string(second)); diff != "" {
+		t.Errorf("previous stubs were loaded (-first +second):\n%s", diff)
+	}
+}
+
+func TestBuildLines(t *testing.T) {
+	p := &main.Package{Options: main.Options{TestOnly: true}}
+	want := []string{"//go:build test_mocks && !nostubs", "// +build test_mocks,!nostubs"}
+	if diff := cmp.Diff(want, p.BuildLines()); diff != "" {
+		t.Errorf("build lines mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "stubs.json")
+	main.Main(nil, []string{"./testdata/bank"}, "", ioutil.Discard, nil, main.Options{Manifest: manifest})
+
+	data, err := ioutil.ReadFile(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []main.ManifestEntry
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []main.ManifestEntry{
+		{Interface: "Account", Package: "github.com/dradtke/stubber/testdata/bank", Impl: "Account", Methods: []string{"Balance", "Close", "SetNickname", "Summarize"}},
+		{Interface: "WithdrawableAccount", Package: "github.com/dradtke/stubber/testdata/bank", Impl: "WithdrawableAccount", Methods: []string{"Balance", "Close", "SetNickname", "Summarize", "Withdraw"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("manifest mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutputDirMode(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "nested", "stubs")
+	main.Main(nil, []string{"./testdata/bank"}, outputDir, nil, nil, main.Options{FileMode: 0664})
+
+	info, err := os.Stat(outputDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("output directory isn't traversable: %s", info.Mode())
+	}
+	info, err = os.Stat(filepath.Join(outputDir, "bank_stubs.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0664 {
+		t.Errorf("got file mode %s, want %s", got, os.FileMode(0664))
+	}
+}
+
+func TestSingleFile(t *testing.T) {
+	inputDirs := []string{"./testdata/singlefile/east", "./testdata/singlefile/west"}
+	outputDir := "./testdata/singlefile/stubs"
+	golden := filepath.Join(outputDir, "stubs_stubs.go")
+	opts := main.Options{SingleFile: true}
+
+	if update {
+		main.Main(nil, inputDirs, outputDir, nil, nil, opts)
+		return
+	}
+
+	var buf bytes.Buffer
+	main.Main(nil, inputDirs, outputDir, &buf, nil, opts)
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if diff := cmp.Diff(string(expected), buf.String()); diff != "" {
 		t.Errorf("output mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestSanitizePackageName(t *testing.T) {
+	for _, tt := range []struct {
+		dir, want string
+	}{
+		{"/src/mocks", "mocks"},
+		{"/src/go-stuff", "gostuff"},
+		{"/src/my.mocks", "mymocks"},
+		{"/src/foo/v2", "foo"},
+		{"/src/go-foo/v3", "gofoo"},
+		{"/v2", "v2"},
+		{"/src/3d", "p3d"},
+		{"/src/type", "ptype"},
+		{"/src/---", "stubs"},
+		{"/src/_", "p_"},
+		{"/src/données", "données"},
+	} {
+		if got := main.SanitizePackageName(filepath.FromSlash(tt.dir)); got != tt.want {
+			t.Errorf("SanitizePackageName(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestInPackageOutputMap(t *testing.T) {
+	opts := main.Options{
+		OutputMap: map[string]string{"bank.Account": "./testdata/bank"},
+	}
+
+	var buf bytes.Buffer
+	main.Main([]string{"Account"}, []string{"./testdata/bank"}, "./testdata/stubs", &buf, nil, opts)
+
+	got := buf.String()
+	for _, want := range []string{"package bank\n", "type StubbedAccount struct", "var _ Account = (*StubbedAccount)(nil)"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `"github.com/dradtke/stubber/testdata/bank"`) {
+		t.Errorf("bank imports itself:\n%s", got)
+	}
+}
+
+func TestConstraintInterfaces(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./testdata/constraints"}, "", &buf, nil, main.Options{})
+
+	got := buf.String()
+	if !strings.Contains(got, "type Sorter[T constraints.Ordered] struct") {
+		t.Errorf("Sorter was not stubbed:\n%s", got)
+	}
+	for _, name := range []string{"Ordered", "Label"} {
+		if strings.Contains(got, "type "+name+" struct") {
+			t.Errorf("constraint interface %s was stubbed:\n%s", name, got)
+		}
+		if msg := "skipping constraints." + name + ": constraint interface, cannot stub"; !strings.Contains(logs.String(), msg) {
+			t.Errorf("missing diagnostic %q in:\n%s", msg, logs.String())
+		}
+	}
+}
+
+func TestZeroFlag(t *testing.T) {
+	// -zero is mapped to Options.ZeroValue by main, so run the command itself.
+	outputDir := filepath.Join(t.TempDir(), "zerovalue")
+	if out, err := exec.Command("go", "run", ".", "-zero", "-output", outputDir, "./testdata/bank").CombinedOutput(); err != nil {
+		t.Fatalf("stubber -zero failed:\n%s", out)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(outputDir, "bank_stubs.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile("./testdata/zerovalue/bank_stubs.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("-zero differs from -zerovalue=noop (-want +got):\n%s", diff)
+	}
+}
+
+func TestStrictRecord(t *testing.T) {
+	if os.Getenv("STUBBER_TEST_STRICT_RECORD") != "" {
+		// Main exits on failure, so this runs in a separate process.
+		main.Main(nil, []string{"./testdata/unrecordable"}, "", ioutil.Discard, nil, main.Options{StrictRecord: true})
+		return
+	}
+
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./testdata/bank"}, "", &buf, nil, main.Options{StrictRecord: true})
+	if buf.Len() == 0 {
+		t.Error("no stubs were generated for a recordable interface")
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestStrictRecord$")
+	cmd.Env = append(os.Environ(), "STUBBER_TEST_STRICT_RECORD=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("generation succeeded with unrecordable parameters:\n%s", out)
+	}
+	for _, want := range []string{
+		"unrecordable.Events.Handle: parameter fn contains a func",
+		"unrecordable.Events.Lookup: parameter filters contains a map",
+		"unrecordable.Events.Subscribe: parameter ch contains a chan",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(string(out), "Publish") {
+		t.Errorf("recordable method was reported:\n%s", out)
+	}
+}
+
+func TestUnknownMethodsOf(t *testing.T) {
+	if os.Getenv("STUBBER_TEST_UNKNOWN_METHODS_OF") != "" {
+		// Main exits on failure, so this runs in a separate process.
+		main.Main(nil, []string{"./testdata/concrete"}, "", ioutil.Discard, nil, main.Options{MethodsOf: []string{"Mailer", "Mailr"}})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestUnknownMethodsOf$")
+	cmd.Env = append(os.Environ(), "STUBBER_TEST_UNKNOWN_METHODS_OF=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("generation succeeded with an unknown type:\n%s", out)
+	}
+	if want := "unknown type Mailr in methods-of"; !strings.Contains(string(out), want) {
+		t.Errorf("missing %q in output:\n%s", want, out)
+	}
+}
+
+func TestUnknownImportPath(t *testing.T) {
+	if os.Getenv("STUBBER_TEST_UNKNOWN_IMPORT_PATH") != "" {
+		// Main exits on failure, so this runs in a separate process.
+		main.Main(nil, []string{"example.com/unknown/errors"}, "", ioutil.Discard, nil, main.Options{})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestUnknownImportPath$")
+	cmd.Env = append(os.Environ(), "STUBBER_TEST_UNKNOWN_IMPORT_PATH=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("generation succeeded with an unknown import path:\n%s", out)
+	}
+	if want := "unable to import package example.com/unknown/errors"; !strings.Contains(string(out), want) {
+		t.Errorf("missing %q in output:\n%s", want, out)
+	}
+	if strings.Contains(string(out), "found package: errors") {
+		t.Errorf("unknown import path was resolved to the standard library:\n%s", out)
+	}
+}
+
+func TestGoldenBehavior(t *testing.T) {
+	// Some golden stubs are exercised by tests of their own.
+	for _, args := range [][]string{
+		{"./testdata/failafter"},
+		{"-race", "./testdata/concurrent"},
+	} {
+		if out, err := exec.Command("go", append([]string{"test"}, args...)...).CombinedOutput(); err != nil {
+			t.Errorf("go test %s failed:\n%s", strings.Join(args, " "), out)
+		}
+	}
+}
+
+func TestInit(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]struct{ src, want string }{
+		"store.go": {
+			src: `package store
+
+import "io"
+
+const Version = 1
+
+// Store persists values.
+type Store interface {
+	Put(key string, r io.Reader) error
+}
+
+type Cache interface {
+	Get(key string) string
+}
+`,
+			want: `package store
+
+import "io"
+
+const Version = 1
+
+//go:generate stubber
+
+// Store persists values.
+type Store interface {
+	Put(key string, r io.Reader) error
+}
+
+type Cache interface {
+	Get(key string) string
+}
+`,
+		},
+		"existing.go": {
+			src: `package store
+
+//go:generate stubber -types Reader
+
+type Reader interface {
+	Read(key string) string
+}
+`,
+		},
+		"types.go": {
+			src: `package store
+
+type Value struct{}
+`,
+		},
+	}
+	for name, f := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(f.src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	main.Init([]string{dir})
+
+	for name, f := range files {
+		want := f.want
+		if want == "" {
+			want = f.src
+		}
+		got, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, string(got)); diff != "" {
+			t.Errorf("%s mismatch (-want +got):\n%s", name, diff)
+		}
+	}
+}
+
+func TestModuleMocks(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		dir     string
+		goldens []string
+	}{
+		{"modmocks", "./testdata/modmocks/billing", []string{"billing_stubs.go", "shipping_stubs.go"}},
+		// Packages of the same name are told apart by their import paths.
+		{"modclash", "./testdata/modclash", []string{"aclient_stubs.go", "bclient_stubs.go"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			inputDirs, outputDir := main.ModuleMocks(tt.dir)
+			opts := main.Options{ExportedOnly: true}
+
+			if update {
+				main.Main(nil, inputDirs, outputDir, nil, nil, opts)
+				cmd := exec.Command("go", "build", "-o", os.DevNull, ".")
+				cmd.Dir = outputDir
+				if v, err := cmd.CombinedOutput(); err != nil {
+					t.Errorf("new golden file failed to build:\n%s", string(v))
+				}
+				return
+			}
+
+			wantDir, _ := filepath.Abs(filepath.Join("./testdata", tt.name, "internal", "mocks"))
+			if outputDir != wantDir {
+				t.Errorf("got output directory %s, want %s", outputDir, wantDir)
+			}
+
+			var buf bytes.Buffer
+			main.Main(nil, inputDirs, outputDir, &buf, nil, opts)
+
+			var expected []byte
+			for _, name := range tt.goldens {
+				b, err := ioutil.ReadFile(filepath.Join(wantDir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected = append(expected, b...)
+			}
+
+			if diff := cmp.Diff(string(expected), buf.String()); diff != "" {
+				t.Errorf("output mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %s\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+	write := func(name, src string) {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("go.mod", "module example.com/since\n\ngo 1.18\n")
+	write("a/a.go", "package a\n\ntype A interface {\n\tGetA() string\n}\n")
+	write("b/b.go", "package b\n")
+	write("c/c.go", "package c\n")
+	write("c/c_stubs.go", "package c\n")
+	run("init", "-q")
+	run("add", ".")
+	run("commit", "-q", "-m", "initial")
+	write("b/b.go", "package b\n\ntype B interface {\n\tGetB() string\n}\n")
+	write("c/c_stubs.go", "package c\n\ntype C struct{}\n")
+
+	inputDirs := []string{filepath.Join(dir, "a"), filepath.Join(dir, "b"), filepath.Join(dir, "c")}
+	got := main.ChangedSince("HEAD", inputDirs)
+	if diff := cmp.Diff([]string{filepath.Join(dir, "b")}, got); diff != "" {
+		t.Errorf("changed directories mismatch (-want +got):\n%s", diff)
+	}
+
+	// A pattern is expanded before the packages that changed are picked out.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	var buf bytes.Buffer
+	main.Main(nil, []string{"./..."}, "stubs", &buf, nil, main.Options{Since: "HEAD"})
+	if !strings.Contains(buf.String(), "func (s *B) GetB() string") {
+		t.Errorf("changed package wasn't stubbed:\n%s", buf.String())
+	}
+	if strings.Contains(buf.String(), "GetA") {
+		t.Errorf("unchanged package was stubbed:\n%s", buf.String())
+	}
+}
+
+func TestSymlinkOutputDir(t *testing.T) {
+	if dir := os.Getenv("STUBBER_TEST_BROKEN_SYMLINK"); dir != "" {
+		// Main exits on failure, so this runs in a separate process.
+		main.Main(nil, []string{"./testdata/bank"}, dir, nil, nil, main.Options{})
+		return
+	}
+
+	dir := t.TempDir()
+	real := filepath.Join(dir, "real")
+	if err := os.Mkdir(real, 0755); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(real, link); err != nil {
+		t.Fatal(err)
+	}
+	main.Main(nil, []string{"./testdata/bank"}, link, nil, nil, main.Options{})
+	if _, err := os.Stat(filepath.Join(real, "bank_stubs.go")); err != nil {
+		t.Errorf("stubs were not written through the symlink: %s", err)
+	}
+
+	broken := filepath.Join(dir, "broken")
+	if err := os.Symlink(filepath.Join(dir, "missing"), broken); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSymlinkOutputDir$")
+	cmd.Env = append(os.Environ(), "STUBBER_TEST_BROKEN_SYMLINK="+broken)
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("generation succeeded with a broken symlink:\n%s", out)
+	}
+	if want := "is a broken symlink to " + filepath.Join(dir, "missing"); !strings.Contains(string(out), want) {
+		t.Errorf("missing %q in output:\n%s", want, out)
+	}
+}
@@ -0,0 +1,149 @@
+package support
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Matcher matches an argument of an expected call. Arguments given to
+// Expectations.Expect that aren't Matchers are matched with Eq.
+type Matcher interface {
+	// Matches reports whether x matches.
+	Matches(x interface{}) bool
+	// String describes what matches, for reporting unexpected calls.
+	String() string
+}
+
+// Any returns a Matcher that matches any argument.
+func Any() Matcher {
+	return anyMatcher{}
+}
+
+type anyMatcher struct{}
+
+func (anyMatcher) Matches(x interface{}) bool {
+	return true
+}
+
+func (anyMatcher) String() string {
+	return "any"
+}
+
+// Eq returns a Matcher that matches arguments deeply equal to value.
+func Eq(value interface{}) Matcher {
+	return eqMatcher{value}
+}
+
+type eqMatcher struct {
+	value interface{}
+}
+
+func (m eqMatcher) Matches(x interface{}) bool {
+	return reflect.DeepEqual(m.value, x)
+}
+
+func (m eqMatcher) String() string {
+	return fmt.Sprintf("%#v", m.value)
+}
+
+// Spread converts a variadic argument into separate arguments for Expect or
+// Call, so that each of its elements is matched on its own.
+func Spread[T any](args []T) []interface{} {
+	spread := make([]interface{}, len(args))
+	for i, arg := range args {
+		spread[i] = arg
+	}
+	return spread
+}
+
+// Expectations holds the calls expected of one method of a stub, in the style
+// of gomock, when stubs are generated with -gomock. R is the type holding the
+// method's results.
+type Expectations[R any] struct {
+	mu       sync.Mutex
+	expected []*Expectation[R]
+}
+
+// Expectation is a call expected of a stub's method. By default it's expected
+// once and returns zero values.
+type Expectation[R any] struct {
+	mu      *sync.Mutex
+	args    []Matcher
+	results R
+	// times is the number of calls left, or negative if there's no limit.
+	times int
+}
+
+// Expect adds an expected call with arguments matching args.
+func (e *Expectations[R]) Expect(args ...interface{}) *Expectation[R] {
+	x := &Expectation[R]{mu: &e.mu, args: make([]Matcher, len(args)), times: 1}
+	for i, arg := range args {
+		if m, ok := arg.(Matcher); ok {
+			x.args[i] = m
+		} else {
+			x.args[i] = Eq(arg)
+		}
+	}
+	e.mu.Lock()
+	e.expected = append(e.expected, x)
+	e.mu.Unlock()
+	return x
+}
+
+// Call returns the results of the first expected call matching args that
+// hasn't been used up. It panics if there's none, naming method.
+func (e *Expectations[R]) Call(method string, args ...interface{}) R {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, x := range e.expected {
+		if x.times != 0 && x.matches(args) {
+			if x.times > 0 {
+				x.times--
+			}
+			return x.results
+		}
+	}
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%#v", arg)
+	}
+	panic(fmt.Sprintf("unexpected call to %s(%s)", method, strings.Join(formatted, ", ")))
+}
+
+func (x *Expectation[R]) matches(args []interface{}) bool {
+	if len(args) != len(x.args) {
+		return false
+	}
+	for i, arg := range args {
+		if !x.args[i].Matches(arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return sets the results of the expected call.
+func (x *Expectation[R]) Return(results R) {
+	x.mu.Lock()
+	x.results = results
+	x.mu.Unlock()
+}
+
+// Times sets the number of times the call is expected.
+func (x *Expectation[R]) Times(n int) {
+	if n < 0 {
+		panic("support: expected call times must not be negative")
+	}
+	x.mu.Lock()
+	x.times = n
+	x.mu.Unlock()
+}
+
+// AnyTimes removes the limit on the number of times the call is expected.
+func (x *Expectation[R]) AnyTimes() {
+	x.mu.Lock()
+	x.times = -1
+	x.mu.Unlock()
+}
@@ -0,0 +1,123 @@
+// Package support provides types that are shared by the code stubber
+// generates, so that they can be used across stubs for different interfaces.
+package support
+
+// CallEvent describes a single call made to a stub. It's delivered to a stub's
+// Sink when stubs are generated with -sink.
+type CallEvent struct {
+	// Stub is the name of the stub type, e.g. "Account".
+	Stub string `json:"stub"`
+	// Method is the name of the method that was called.
+	Method string `json:"method"`
+	// Args holds the parameters of the call, in the same form as an element
+	// of the stub's Calls accessor for the method.
+	Args interface{} `json:"args"`
+}
+
+// CallStore stores the calls recorded by a stub for one of its methods when
+// stubs are generated with -callstore. T is the type holding a call's
+// parameters.
+type CallStore[T any] interface {
+	// Record stores call.
+	Record(call T)
+	// Calls returns the stored calls in the order they were recorded.
+	Calls() []T
+	// Len returns the number of calls that have been recorded, including
+	// any that are no longer stored.
+	Len() int
+	// Reset discards the stored calls, so that Len returns zero.
+	Reset()
+}
+
+// Record stores call in *store, which is first set to a new SliceStore if
+// it's nil.
+func Record[T any](store *CallStore[T], call T) {
+	if *store == nil {
+		*store = &SliceStore[T]{}
+	}
+	(*store).Record(call)
+}
+
+// SliceStore is a CallStore that keeps every call in memory.
+type SliceStore[T any] struct {
+	calls []T
+}
+
+func (s *SliceStore[T]) Record(call T) {
+	s.calls = append(s.calls, call)
+}
+
+func (s *SliceStore[T]) Calls() []T {
+	return s.calls
+}
+
+func (s *SliceStore[T]) Len() int {
+	return len(s.calls)
+}
+
+func (s *SliceStore[T]) Reset() {
+	s.calls = nil
+}
+
+// RingStore is a CallStore that keeps only the most recent calls, up to a
+// fixed number.
+type RingStore[T any] struct {
+	calls []T
+	next  int
+	n     int
+}
+
+// NewRingStore returns a RingStore that keeps the last size calls.
+func NewRingStore[T any](size int) *RingStore[T] {
+	if size <= 0 {
+		panic("support: ring store size must be positive")
+	}
+	return &RingStore[T]{calls: make([]T, 0, size)}
+}
+
+func (s *RingStore[T]) Record(call T) {
+	s.n++
+	if len(s.calls) < cap(s.calls) {
+		s.calls = append(s.calls, call)
+		return
+	}
+	s.calls[s.next] = call
+	s.next = (s.next + 1) % len(s.calls)
+}
+
+func (s *RingStore[T]) Calls() []T {
+	calls := make([]T, 0, len(s.calls))
+	calls = append(calls, s.calls[s.next:]...)
+	return append(calls, s.calls[:s.next]...)
+}
+
+func (s *RingStore[T]) Len() int {
+	return s.n
+}
+
+func (s *RingStore[T]) Reset() {
+	s.calls = s.calls[:0]
+	s.next = 0
+	s.n = 0
+}
+
+// CountStore is a CallStore that only counts calls without storing them.
+type CountStore[T any] struct {
+	n int
+}
+
+func (s *CountStore[T]) Record(call T) {
+	s.n++
+}
+
+func (s *CountStore[T]) Calls() []T {
+	return nil
+}
+
+func (s *CountStore[T]) Len() int {
+	return s.n
+}
+
+func (s *CountStore[T]) Reset() {
+	s.n = 0
+}
@@ -0,0 +1,68 @@
+package support_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dradtke/stubber/support"
+)
+
+func TestRingStore(t *testing.T) {
+	s := support.NewRingStore[int](3)
+	for i := 1; i <= 5; i++ {
+		s.Record(i)
+	}
+	if diff := cmp.Diff([]int{3, 4, 5}, s.Calls()); diff != "" {
+		t.Errorf("calls mismatch (-want +got):\n%s", diff)
+	}
+	if got := s.Len(); got != 5 {
+		t.Errorf("got length %d, want 5", got)
+	}
+}
+
+func TestRingStoreReset(t *testing.T) {
+	s := support.NewRingStore[int](2)
+	for i := 1; i <= 3; i++ {
+		s.Record(i)
+	}
+	s.Reset()
+	s.Record(4)
+	if diff := cmp.Diff([]int{4}, s.Calls()); diff != "" {
+		t.Errorf("calls mismatch (-want +got):\n%s", diff)
+	}
+	if got := s.Len(); got != 1 {
+		t.Errorf("got length %d, want 1", got)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	var store support.CallStore[string]
+	support.Record(&store, "a")
+	support.Record(&store, "b")
+	if diff := cmp.Diff([]string{"a", "b"}, store.Calls()); diff != "" {
+		t.Errorf("calls mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpectations(t *testing.T) {
+	var e support.Expectations[int]
+	e.Expect("a", support.Any()).Return(1)
+	e.Expect("b", 2).AnyTimes()
+
+	if got := e.Call("X", "a", 5); got != 1 {
+		t.Errorf("got %d, want 1", got)
+	}
+	for i := 0; i < 2; i++ {
+		if got := e.Call("X", "b", 2); got != 0 {
+			t.Errorf("got %d, want 0", got)
+		}
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != `unexpected call to X("a", 5)` {
+			t.Errorf("got panic %v", recovered)
+		}
+	}()
+	e.Call("X", "a", 5)
+}
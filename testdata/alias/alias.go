@@ -0,0 +1,11 @@
+package alias
+
+import "io"
+
+// ByteSource is an alias of an interface declared in another package.
+type ByteSource = io.ByteReader
+
+// Flusher is an alias of an unnamed interface.
+type Flusher = interface {
+	Flush() error
+}
@@ -0,0 +1,440 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package assertused
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+	"testing"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Account) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *Account) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Account) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Account) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Account) Close() error {
+	if s.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Account) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Account) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Account) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *Account) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Account) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *Account) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Account) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// AssertAllStubsUsed registers a cleanup with tb that reports an error for
+// each stub of s that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+func (s *Account) AssertAllStubsUsed(tb testing.TB) {
+	tb.Cleanup(func() {
+		if s.BalanceStub != nil && len(s.balanceCalls) == 0 {
+			tb.Errorf("Account: BalanceStub was set, but Balance was never called")
+		}
+		if s.CloseStub != nil && len(s.closeCalls) == 0 {
+			tb.Errorf("Account: CloseStub was set, but Close was never called")
+		}
+		if s.SetNicknameStub != nil && len(s.setNicknameCalls) == 0 {
+			tb.Errorf("Account: SetNicknameStub was set, but SetNickname was never called")
+		}
+		if s.SummarizeStub != nil && len(s.summarizeCalls) == 0 {
+			tb.Errorf("Account: SummarizeStub was set, but Summarize was never called")
+		}
+	})
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Account) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *WithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *WithdrawableAccount) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *WithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *WithdrawableAccount) Close() error {
+	if s.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *WithdrawableAccount) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *WithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *WithdrawableAccount) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *WithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *WithdrawableAccount) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *WithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if s.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	s.withdrawCalls = append(s.withdrawCalls, struct{ Amount int }{Amount: amount})
+	return (s.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return s.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (s *WithdrawableAccount) WithdrawCallCount() int {
+	return len(s.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *WithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// AssertAllStubsUsed registers a cleanup with tb that reports an error for
+// each stub of s that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+func (s *WithdrawableAccount) AssertAllStubsUsed(tb testing.TB) {
+	tb.Cleanup(func() {
+		if s.BalanceStub != nil && len(s.balanceCalls) == 0 {
+			tb.Errorf("WithdrawableAccount: BalanceStub was set, but Balance was never called")
+		}
+		if s.CloseStub != nil && len(s.closeCalls) == 0 {
+			tb.Errorf("WithdrawableAccount: CloseStub was set, but Close was never called")
+		}
+		if s.SetNicknameStub != nil && len(s.setNicknameCalls) == 0 {
+			tb.Errorf("WithdrawableAccount: SetNicknameStub was set, but SetNickname was never called")
+		}
+		if s.SummarizeStub != nil && len(s.summarizeCalls) == 0 {
+			tb.Errorf("WithdrawableAccount: SummarizeStub was set, but Summarize was never called")
+		}
+		if s.WithdrawStub != nil && len(s.withdrawCalls) == 0 {
+			tb.Errorf("WithdrawableAccount: WithdrawStub was set, but Withdraw was never called")
+		}
+	})
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *WithdrawableAccount) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+	s.withdrawCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
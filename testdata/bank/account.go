@@ -14,6 +14,8 @@ var (
 type Account interface {
 	Summarize(w io.Writer)
 	Balance() int
+	SetNickname(s string)
+	Close() (err error)
 }
 
 type WithdrawableAccount interface {
@@ -0,0 +1,395 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package bank
+
+import (
+	"io"
+)
+
+// StubbedAccount is a stubbed implementation of bank.Account.
+type StubbedAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewStubbedAccount returns a new StubbedAccount without any stubs set.
+func NewStubbedAccount() *StubbedAccount {
+	return &StubbedAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *StubbedAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("StubbedAccount.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedAccount) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *StubbedAccount) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *StubbedAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *StubbedAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *StubbedAccount) Close() error {
+	if s.CloseStub == nil {
+		panic("StubbedAccount.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedAccount) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *StubbedAccount) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *StubbedAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *StubbedAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *StubbedAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("StubbedAccount.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedAccount) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *StubbedAccount) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *StubbedAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *StubbedAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *StubbedAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("StubbedAccount.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *StubbedAccount) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *StubbedAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *StubbedAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *StubbedAccount) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ Account = (*StubbedAccount)(nil)
+
+// StubbedWithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type StubbedWithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+}
+
+// NewStubbedWithdrawableAccount returns a new StubbedWithdrawableAccount without any stubs set.
+func NewStubbedWithdrawableAccount() *StubbedWithdrawableAccount {
+	return &StubbedWithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *StubbedWithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("StubbedWithdrawableAccount.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedWithdrawableAccount) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *StubbedWithdrawableAccount) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *StubbedWithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *StubbedWithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *StubbedWithdrawableAccount) Close() error {
+	if s.CloseStub == nil {
+		panic("StubbedWithdrawableAccount.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedWithdrawableAccount) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *StubbedWithdrawableAccount) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *StubbedWithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *StubbedWithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *StubbedWithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("StubbedWithdrawableAccount.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedWithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *StubbedWithdrawableAccount) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *StubbedWithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *StubbedWithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *StubbedWithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("StubbedWithdrawableAccount.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedWithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *StubbedWithdrawableAccount) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *StubbedWithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *StubbedWithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *StubbedWithdrawableAccount) Withdraw(amount int) (int, error) {
+	if s.WithdrawStub == nil {
+		panic("StubbedWithdrawableAccount.Withdraw: nil method stub")
+	}
+	s.withdrawCalls = append(s.withdrawCalls, struct{ Amount int }{Amount: amount})
+	return (s.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (s *StubbedWithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return s.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (s *StubbedWithdrawableAccount) WithdrawCallCount() int {
+	return len(s.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *StubbedWithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *StubbedWithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *StubbedWithdrawableAccount) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+	s.withdrawCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ WithdrawableAccount = (*StubbedWithdrawableAccount)(nil)
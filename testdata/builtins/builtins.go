@@ -0,0 +1,11 @@
+package builtins
+
+// Checker only uses universe types, so its stubs shouldn't import anything
+// but this package.
+type Checker interface {
+	Check(name string, data []byte, r rune) error
+	Valid(v interface{}) bool
+	Errors() []error
+	Stats() (count int, ratio float64, total uint64)
+	Lookup(m map[string]error) (complex128, error)
+}
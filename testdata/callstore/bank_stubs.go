@@ -0,0 +1,545 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package callstore
+
+import (
+	"github.com/dradtke/stubber/support"
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// Sink, if set, is sent an event for every call made to the stub.
+	Sink func(support.CallEvent)
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub func() int
+	// BalanceCallStore, if set, stores the calls made to Balance. It
+	// defaults to a support.SliceStore.
+	BalanceCallStore support.CallStore[struct{}]
+	// CloseStub defines the implementation for Close.
+	CloseStub func() error
+	// CloseCallStore, if set, stores the calls made to Close. It
+	// defaults to a support.SliceStore.
+	CloseCallStore support.CallStore[struct{}]
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub func(_s string)
+	// SetNicknameCallStore, if set, stores the calls made to SetNickname. It
+	// defaults to a support.SliceStore.
+	SetNicknameCallStore support.CallStore[struct{ S string }]
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub func(w io.Writer)
+	// SummarizeCallStore, if set, stores the calls made to Summarize. It
+	// defaults to a support.SliceStore.
+	SummarizeCallStore support.CallStore[struct{ W io.Writer }]
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Account) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	support.Record(&s.BalanceCallStore, struct{}{})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "Account", Method: "Balance", Args: struct{}{}})
+	}
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) BalanceCalls() []struct{} {
+	if s.BalanceCallStore == nil {
+		return nil
+	}
+	return s.BalanceCallStore.Calls()
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *Account) BalanceCallCount() int {
+	if s.BalanceCallStore == nil {
+		return 0
+	}
+	return s.BalanceCallStore.Len()
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Account) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Account) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Account) Close() error {
+	support.Record(&s.CloseCallStore, struct{}{})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "Account", Method: "Close", Args: struct{}{}})
+	}
+	if s.CloseFailAfter > 0 && s.CloseCallStore.Len() > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	if s.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) CloseCalls() []struct{} {
+	if s.CloseCallStore == nil {
+		return nil
+	}
+	return s.CloseCallStore.Calls()
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Account) CloseCallCount() int {
+	if s.CloseCallStore == nil {
+		return 0
+	}
+	return s.CloseCallStore.Len()
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Account) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Account) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	support.Record(&s.SetNicknameCallStore, struct{ S string }{S: _s})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "Account", Method: "SetNickname", Args: struct{ S string }{S: _s}})
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SetNicknameCalls() []struct{ S string } {
+	if s.SetNicknameCallStore == nil {
+		return nil
+	}
+	return s.SetNicknameCallStore.Calls()
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *Account) SetNicknameCallCount() int {
+	if s.SetNicknameCallStore == nil {
+		return 0
+	}
+	return s.SetNicknameCallStore.Len()
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Account) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	support.Record(&s.SummarizeCallStore, struct{ W io.Writer }{W: w})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "Account", Method: "Summarize", Args: struct{ W io.Writer }{W: w}})
+	}
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SummarizeCalls() []struct{ W io.Writer } {
+	if s.SummarizeCallStore == nil {
+		return nil
+	}
+	return s.SummarizeCallStore.Calls()
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *Account) SummarizeCallCount() int {
+	if s.SummarizeCallStore == nil {
+		return 0
+	}
+	return s.SummarizeCallStore.Len()
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Account) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Account) Reset() {
+	if s.BalanceCallStore != nil {
+		s.BalanceCallStore.Reset()
+	}
+	if s.CloseCallStore != nil {
+		s.CloseCallStore.Reset()
+	}
+	if s.SetNicknameCallStore != nil {
+		s.SetNicknameCallStore.Reset()
+	}
+	if s.SummarizeCallStore != nil {
+		s.SummarizeCallStore.Reset()
+	}
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// Sink, if set, is sent an event for every call made to the stub.
+	Sink func(support.CallEvent)
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub func() int
+	// BalanceCallStore, if set, stores the calls made to Balance. It
+	// defaults to a support.SliceStore.
+	BalanceCallStore support.CallStore[struct{}]
+	// CloseStub defines the implementation for Close.
+	CloseStub func() error
+	// CloseCallStore, if set, stores the calls made to Close. It
+	// defaults to a support.SliceStore.
+	CloseCallStore support.CallStore[struct{}]
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub func(_s string)
+	// SetNicknameCallStore, if set, stores the calls made to SetNickname. It
+	// defaults to a support.SliceStore.
+	SetNicknameCallStore support.CallStore[struct{ S string }]
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub func(w io.Writer)
+	// SummarizeCallStore, if set, stores the calls made to Summarize. It
+	// defaults to a support.SliceStore.
+	SummarizeCallStore support.CallStore[struct{ W io.Writer }]
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub func(amount int) (int, error)
+	// WithdrawCallStore, if set, stores the calls made to Withdraw. It
+	// defaults to a support.SliceStore.
+	WithdrawCallStore support.CallStore[struct{ Amount int }]
+	// WithdrawFailAfter, if positive, is the number of calls to Withdraw that
+	// succeed before it starts failing with WithdrawFailError.
+	WithdrawFailAfter int
+	// WithdrawFailError is the error returned by Withdraw once
+	// WithdrawFailAfter calls have been made.
+	WithdrawFailError error
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *WithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	support.Record(&s.BalanceCallStore, struct{}{})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "WithdrawableAccount", Method: "Balance", Args: struct{}{}})
+	}
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) BalanceCalls() []struct{} {
+	if s.BalanceCallStore == nil {
+		return nil
+	}
+	return s.BalanceCallStore.Calls()
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *WithdrawableAccount) BalanceCallCount() int {
+	if s.BalanceCallStore == nil {
+		return 0
+	}
+	return s.BalanceCallStore.Len()
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *WithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *WithdrawableAccount) Close() error {
+	support.Record(&s.CloseCallStore, struct{}{})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "WithdrawableAccount", Method: "Close", Args: struct{}{}})
+	}
+	if s.CloseFailAfter > 0 && s.CloseCallStore.Len() > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	if s.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) CloseCalls() []struct{} {
+	if s.CloseCallStore == nil {
+		return nil
+	}
+	return s.CloseCallStore.Calls()
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *WithdrawableAccount) CloseCallCount() int {
+	if s.CloseCallStore == nil {
+		return 0
+	}
+	return s.CloseCallStore.Len()
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *WithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	support.Record(&s.SetNicknameCallStore, struct{ S string }{S: _s})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "WithdrawableAccount", Method: "SetNickname", Args: struct{ S string }{S: _s}})
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	if s.SetNicknameCallStore == nil {
+		return nil
+	}
+	return s.SetNicknameCallStore.Calls()
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *WithdrawableAccount) SetNicknameCallCount() int {
+	if s.SetNicknameCallStore == nil {
+		return 0
+	}
+	return s.SetNicknameCallStore.Len()
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *WithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	support.Record(&s.SummarizeCallStore, struct{ W io.Writer }{W: w})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "WithdrawableAccount", Method: "Summarize", Args: struct{ W io.Writer }{W: w}})
+	}
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	if s.SummarizeCallStore == nil {
+		return nil
+	}
+	return s.SummarizeCallStore.Calls()
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *WithdrawableAccount) SummarizeCallCount() int {
+	if s.SummarizeCallStore == nil {
+		return 0
+	}
+	return s.SummarizeCallStore.Len()
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *WithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	support.Record(&s.WithdrawCallStore, struct{ Amount int }{Amount: amount})
+	if s.Sink != nil {
+		s.Sink(support.CallEvent{Stub: "WithdrawableAccount", Method: "Withdraw", Args: struct{ Amount int }{Amount: amount}})
+	}
+	if s.WithdrawFailAfter > 0 && s.WithdrawCallStore.Len() > s.WithdrawFailAfter {
+		return 0, s.WithdrawFailError
+	}
+	if s.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	return (s.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	if s.WithdrawCallStore == nil {
+		return nil
+	}
+	return s.WithdrawCallStore.Calls()
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (s *WithdrawableAccount) WithdrawCallCount() int {
+	if s.WithdrawCallStore == nil {
+		return 0
+	}
+	return s.WithdrawCallStore.Len()
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *WithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *WithdrawableAccount) Reset() {
+	if s.BalanceCallStore != nil {
+		s.BalanceCallStore.Reset()
+	}
+	if s.CloseCallStore != nil {
+		s.CloseCallStore.Reset()
+	}
+	if s.SetNicknameCallStore != nil {
+		s.SetNicknameCallStore.Reset()
+	}
+	if s.SummarizeCallStore != nil {
+		s.SummarizeCallStore.Reset()
+	}
+	if s.WithdrawCallStore != nil {
+		s.WithdrawCallStore.Reset()
+	}
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
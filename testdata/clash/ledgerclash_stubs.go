@@ -0,0 +1,746 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package clash
+
+import (
+	"github.com/dradtke/stubber/testdata/ledgerclash"
+	"testing"
+)
+
+// Statement is a stubbed implementation of ledgerclash.Statement.
+type Statement struct {
+	callLog []string
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// BalanceReturns holds results returned, in order, by calls to Balance
+	// while BalanceStub is nil. Each one is removed once it's returned.
+	BalanceReturns []struct{ R0 int }
+	// BalanceCallCountStub defines the implementation for BalanceCallCount.
+	BalanceCallCountStub  func() int
+	balanceCallCountCalls []struct{}
+	// BalanceCallCountReturns holds results returned, in order, by calls to BalanceCallCount
+	// while BalanceCallCountStub is nil. Each one is removed once it's returned.
+	BalanceCallCountReturns []struct{ R0 int }
+	// BalanceCallsStub defines the implementation for BalanceCalls.
+	BalanceCallsStub  func() []int
+	balanceCallsCalls []struct{}
+	// BalanceCallsReturns holds results returned, in order, by calls to BalanceCalls
+	// while BalanceCallsStub is nil. Each one is removed once it's returned.
+	BalanceCallsReturns []struct{ R0 []int }
+	// CallLogStub defines the implementation for CallLog.
+	CallLogStub  func() []string
+	callLogCalls []struct{}
+	// CallLogReturns holds results returned, in order, by calls to CallLog
+	// while CallLogStub is nil. Each one is removed once it's returned.
+	CallLogReturns []struct{ R0 []string }
+	// CloneStub defines the implementation for Clone.
+	CloneStub  func() ledgerclash.Statement
+	cloneCalls []struct{}
+	// CloneReturns holds results returned, in order, by calls to Clone
+	// while CloneStub is nil. Each one is removed once it's returned.
+	CloneReturns []struct{ R0 ledgerclash.Statement }
+	// MarshalTextStub defines the implementation for MarshalText.
+	MarshalTextStub  func() ([]byte, error)
+	marshalTextCalls []struct{}
+	// MarshalTextReturns holds results returned, in order, by calls to MarshalText
+	// while MarshalTextStub is nil. Each one is removed once it's returned.
+	MarshalTextReturns []struct {
+		R0 []byte
+		R1 error
+	}
+	// SinkStub defines the implementation for Sink.
+	SinkStub  func(entry string)
+	sinkCalls []struct{ Entry string }
+	// TBStub defines the implementation for TB.
+	TBStub  func() int
+	tBCalls []struct{}
+	// TBReturns holds results returned, in order, by calls to TB
+	// while TBStub is nil. Each one is removed once it's returned.
+	TBReturns []struct{ R0 int }
+	// TotalStub_ defines the implementation for Total.
+	TotalStub_ func() int
+	totalCalls []struct{}
+	// TotalReturns holds results returned, in order, by calls to Total
+	// while TotalStub_ is nil. Each one is removed once it's returned.
+	TotalReturns []struct{ R0 int }
+	// TotalStubStub defines the implementation for TotalStub.
+	TotalStubStub  func() int
+	totalStubCalls []struct{}
+	// TotalStubReturns holds results returned, in order, by calls to TotalStub
+	// while TotalStubStub is nil. Each one is removed once it's returned.
+	TotalStubReturns []struct{ R0 int }
+	// ValidateStub defines the implementation for Validate.
+	ValidateStub  func() error
+	validateCalls []struct{}
+	// ValidateReturns holds results returned, in order, by calls to Validate
+	// while ValidateStub is nil. Each one is removed once it's returned.
+	ValidateReturns []struct{ R0 error }
+}
+
+// NewStatement returns a new Statement without any stubs set.
+func NewStatement() *Statement {
+	return &Statement{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Statement) Balance() int {
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	s.callLog = append(s.callLog, "Balance")
+	next := s.nextBalanceReturns()
+	if s.BalanceStub == nil && next == nil {
+		panic("Statement.Balance: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.BalanceStub)()
+}
+
+// nextBalanceReturns removes and returns the first of BalanceReturns, or
+// nil if BalanceStub is set or there are none left.
+func (s *Statement) nextBalanceReturns() *struct{ R0 int } {
+	if s.BalanceStub != nil {
+		return nil
+	}
+	if len(s.BalanceReturns) == 0 {
+		return nil
+	}
+	next := s.BalanceReturns[0]
+	s.BalanceReturns = s.BalanceReturns[1:]
+	return &next
+}
+
+// BalanceCalls_ returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCalls_() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount_ returns the number of calls made to Balance.
+func (s *Statement) BalanceCallCount_() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Statement) BalanceCalled() bool {
+	return s.BalanceCallCount_() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Statement) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls_()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// BalanceCallCount delegates its behavior to the field BalanceCallCountStub.
+func (s *Statement) BalanceCallCount() int {
+	s.balanceCallCountCalls = append(s.balanceCallCountCalls, struct{}{})
+	s.callLog = append(s.callLog, "BalanceCallCount")
+	next := s.nextBalanceCallCountReturns()
+	if s.BalanceCallCountStub == nil && next == nil {
+		panic("Statement.BalanceCallCount: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.BalanceCallCountStub)()
+}
+
+// nextBalanceCallCountReturns removes and returns the first of BalanceCallCountReturns, or
+// nil if BalanceCallCountStub is set or there are none left.
+func (s *Statement) nextBalanceCallCountReturns() *struct{ R0 int } {
+	if s.BalanceCallCountStub != nil {
+		return nil
+	}
+	if len(s.BalanceCallCountReturns) == 0 {
+		return nil
+	}
+	next := s.BalanceCallCountReturns[0]
+	s.BalanceCallCountReturns = s.BalanceCallCountReturns[1:]
+	return &next
+}
+
+// BalanceCallCountCalls returns a slice of calls made to BalanceCallCount. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCallCountCalls() []struct{} {
+	return s.balanceCallCountCalls
+}
+
+// BalanceCallCountCallCount returns the number of calls made to BalanceCallCount.
+func (s *Statement) BalanceCallCountCallCount() int {
+	return len(s.balanceCallCountCalls)
+}
+
+// BalanceCallCountCalled reports whether BalanceCallCount has been called.
+func (s *Statement) BalanceCallCountCalled() bool {
+	return s.BalanceCallCountCallCount() > 0
+}
+
+// BalanceCallCountLastCall returns the parameters of the most recent call to BalanceCallCount,
+// and whether there has been one.
+func (s *Statement) BalanceCallCountLastCall() (struct{}, bool) {
+	calls := s.BalanceCallCountCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// BalanceCalls delegates its behavior to the field BalanceCallsStub.
+func (s *Statement) BalanceCalls() []int {
+	s.balanceCallsCalls = append(s.balanceCallsCalls, struct{}{})
+	s.callLog = append(s.callLog, "BalanceCalls")
+	next := s.nextBalanceCallsReturns()
+	if s.BalanceCallsStub == nil && next == nil {
+		panic("Statement.BalanceCalls: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.BalanceCallsStub)()
+}
+
+// nextBalanceCallsReturns removes and returns the first of BalanceCallsReturns, or
+// nil if BalanceCallsStub is set or there are none left.
+func (s *Statement) nextBalanceCallsReturns() *struct{ R0 []int } {
+	if s.BalanceCallsStub != nil {
+		return nil
+	}
+	if len(s.BalanceCallsReturns) == 0 {
+		return nil
+	}
+	next := s.BalanceCallsReturns[0]
+	s.BalanceCallsReturns = s.BalanceCallsReturns[1:]
+	return &next
+}
+
+// BalanceCallsCalls returns a slice of calls made to BalanceCalls. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCallsCalls() []struct{} {
+	return s.balanceCallsCalls
+}
+
+// BalanceCallsCallCount returns the number of calls made to BalanceCalls.
+func (s *Statement) BalanceCallsCallCount() int {
+	return len(s.balanceCallsCalls)
+}
+
+// BalanceCallsCalled reports whether BalanceCalls has been called.
+func (s *Statement) BalanceCallsCalled() bool {
+	return s.BalanceCallsCallCount() > 0
+}
+
+// BalanceCallsLastCall returns the parameters of the most recent call to BalanceCalls,
+// and whether there has been one.
+func (s *Statement) BalanceCallsLastCall() (struct{}, bool) {
+	calls := s.BalanceCallsCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// CallLog delegates its behavior to the field CallLogStub.
+func (s *Statement) CallLog() []string {
+	s.callLogCalls = append(s.callLogCalls, struct{}{})
+	s.callLog = append(s.callLog, "CallLog")
+	next := s.nextCallLogReturns()
+	if s.CallLogStub == nil && next == nil {
+		panic("Statement.CallLog: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.CallLogStub)()
+}
+
+// nextCallLogReturns removes and returns the first of CallLogReturns, or
+// nil if CallLogStub is set or there are none left.
+func (s *Statement) nextCallLogReturns() *struct{ R0 []string } {
+	if s.CallLogStub != nil {
+		return nil
+	}
+	if len(s.CallLogReturns) == 0 {
+		return nil
+	}
+	next := s.CallLogReturns[0]
+	s.CallLogReturns = s.CallLogReturns[1:]
+	return &next
+}
+
+// CallLogCalls returns a slice of calls made to CallLog. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) CallLogCalls() []struct{} {
+	return s.callLogCalls
+}
+
+// CallLogCallCount returns the number of calls made to CallLog.
+func (s *Statement) CallLogCallCount() int {
+	return len(s.callLogCalls)
+}
+
+// CallLogCalled reports whether CallLog has been called.
+func (s *Statement) CallLogCalled() bool {
+	return s.CallLogCallCount() > 0
+}
+
+// CallLogLastCall returns the parameters of the most recent call to CallLog,
+// and whether there has been one.
+func (s *Statement) CallLogLastCall() (struct{}, bool) {
+	calls := s.CallLogCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Clone delegates its behavior to the field CloneStub.
+func (s *Statement) Clone() ledgerclash.Statement {
+	s.cloneCalls = append(s.cloneCalls, struct{}{})
+	s.callLog = append(s.callLog, "Clone")
+	next := s.nextCloneReturns()
+	if s.CloneStub == nil && next == nil {
+		panic("Statement.Clone: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.CloneStub)()
+}
+
+// nextCloneReturns removes and returns the first of CloneReturns, or
+// nil if CloneStub is set or there are none left.
+func (s *Statement) nextCloneReturns() *struct{ R0 ledgerclash.Statement } {
+	if s.CloneStub != nil {
+		return nil
+	}
+	if len(s.CloneReturns) == 0 {
+		return nil
+	}
+	next := s.CloneReturns[0]
+	s.CloneReturns = s.CloneReturns[1:]
+	return &next
+}
+
+// CloneCalls returns a slice of calls made to Clone. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) CloneCalls() []struct{} {
+	return s.cloneCalls
+}
+
+// CloneCallCount returns the number of calls made to Clone.
+func (s *Statement) CloneCallCount() int {
+	return len(s.cloneCalls)
+}
+
+// CloneCalled reports whether Clone has been called.
+func (s *Statement) CloneCalled() bool {
+	return s.CloneCallCount() > 0
+}
+
+// CloneLastCall returns the parameters of the most recent call to Clone,
+// and whether there has been one.
+func (s *Statement) CloneLastCall() (struct{}, bool) {
+	calls := s.CloneCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// MarshalText delegates its behavior to the field MarshalTextStub.
+func (s *Statement) MarshalText() ([]byte, error) {
+	s.marshalTextCalls = append(s.marshalTextCalls, struct{}{})
+	s.callLog = append(s.callLog, "MarshalText")
+	next := s.nextMarshalTextReturns()
+	if s.MarshalTextStub == nil && next == nil {
+		panic("Statement.MarshalText: nil method stub")
+	}
+	if next != nil {
+		return next.R0, next.R1
+	}
+	return (s.MarshalTextStub)()
+}
+
+// nextMarshalTextReturns removes and returns the first of MarshalTextReturns, or
+// nil if MarshalTextStub is set or there are none left.
+func (s *Statement) nextMarshalTextReturns() *struct {
+	R0 []byte
+	R1 error
+} {
+	if s.MarshalTextStub != nil {
+		return nil
+	}
+	if len(s.MarshalTextReturns) == 0 {
+		return nil
+	}
+	next := s.MarshalTextReturns[0]
+	s.MarshalTextReturns = s.MarshalTextReturns[1:]
+	return &next
+}
+
+// MarshalTextCalls returns a slice of calls made to MarshalText. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) MarshalTextCalls() []struct{} {
+	return s.marshalTextCalls
+}
+
+// MarshalTextCallCount returns the number of calls made to MarshalText.
+func (s *Statement) MarshalTextCallCount() int {
+	return len(s.marshalTextCalls)
+}
+
+// MarshalTextCalled reports whether MarshalText has been called.
+func (s *Statement) MarshalTextCalled() bool {
+	return s.MarshalTextCallCount() > 0
+}
+
+// MarshalTextLastCall returns the parameters of the most recent call to MarshalText,
+// and whether there has been one.
+func (s *Statement) MarshalTextLastCall() (struct{}, bool) {
+	calls := s.MarshalTextCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Sink delegates its behavior to the field SinkStub.
+func (s *Statement) Sink(entry string) {
+	if s.SinkStub == nil {
+		panic("Statement.Sink: nil method stub")
+	}
+	s.sinkCalls = append(s.sinkCalls, struct{ Entry string }{Entry: entry})
+	s.callLog = append(s.callLog, "Sink")
+	(s.SinkStub)(entry)
+}
+
+// SinkCalls returns a slice of calls made to Sink. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) SinkCalls() []struct{ Entry string } {
+	return s.sinkCalls
+}
+
+// SinkCallCount returns the number of calls made to Sink.
+func (s *Statement) SinkCallCount() int {
+	return len(s.sinkCalls)
+}
+
+// SinkCalled reports whether Sink has been called.
+func (s *Statement) SinkCalled() bool {
+	return s.SinkCallCount() > 0
+}
+
+// SinkLastCall returns the parameters of the most recent call to Sink,
+// and whether there has been one.
+func (s *Statement) SinkLastCall() (struct{ Entry string }, bool) {
+	calls := s.SinkCalls()
+	if len(calls) == 0 {
+		return struct{ Entry string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SinkCallCountByArg returns the number of calls made to Sink for each
+// distinct argument.
+func (s *Statement) SinkCallCountByArg() map[string]int {
+	counts := make(map[string]int)
+	for _, call := range s.SinkCalls() {
+		counts[call.Entry]++
+	}
+	return counts
+}
+
+// SinkFirstMatching returns the first recorded call to Sink for which
+// pred returns true, and false if there isn't one.
+func (s *Statement) SinkFirstMatching(pred func(struct{ Entry string }) bool) (struct{ Entry string }, bool) {
+	for _, call := range s.SinkCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ Entry string }{}, false
+}
+
+// TB delegates its behavior to the field TBStub.
+func (s *Statement) TB() int {
+	s.tBCalls = append(s.tBCalls, struct{}{})
+	s.callLog = append(s.callLog, "TB")
+	next := s.nextTBReturns()
+	if s.TBStub == nil && next == nil {
+		panic("Statement.TB: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.TBStub)()
+}
+
+// nextTBReturns removes and returns the first of TBReturns, or
+// nil if TBStub is set or there are none left.
+func (s *Statement) nextTBReturns() *struct{ R0 int } {
+	if s.TBStub != nil {
+		return nil
+	}
+	if len(s.TBReturns) == 0 {
+		return nil
+	}
+	next := s.TBReturns[0]
+	s.TBReturns = s.TBReturns[1:]
+	return &next
+}
+
+// TBCalls returns a slice of calls made to TB. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TBCalls() []struct{} {
+	return s.tBCalls
+}
+
+// TBCallCount returns the number of calls made to TB.
+func (s *Statement) TBCallCount() int {
+	return len(s.tBCalls)
+}
+
+// TBCalled reports whether TB has been called.
+func (s *Statement) TBCalled() bool {
+	return s.TBCallCount() > 0
+}
+
+// TBLastCall returns the parameters of the most recent call to TB,
+// and whether there has been one.
+func (s *Statement) TBLastCall() (struct{}, bool) {
+	calls := s.TBCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Total delegates its behavior to the field TotalStub_.
+func (s *Statement) Total() int {
+	s.totalCalls = append(s.totalCalls, struct{}{})
+	s.callLog = append(s.callLog, "Total")
+	next := s.nextTotalReturns()
+	if s.TotalStub_ == nil && next == nil {
+		panic("Statement.Total: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.TotalStub_)()
+}
+
+// nextTotalReturns removes and returns the first of TotalReturns, or
+// nil if TotalStub_ is set or there are none left.
+func (s *Statement) nextTotalReturns() *struct{ R0 int } {
+	if s.TotalStub_ != nil {
+		return nil
+	}
+	if len(s.TotalReturns) == 0 {
+		return nil
+	}
+	next := s.TotalReturns[0]
+	s.TotalReturns = s.TotalReturns[1:]
+	return &next
+}
+
+// TotalCalls returns a slice of calls made to Total. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TotalCalls() []struct{} {
+	return s.totalCalls
+}
+
+// TotalCallCount returns the number of calls made to Total.
+func (s *Statement) TotalCallCount() int {
+	return len(s.totalCalls)
+}
+
+// TotalCalled reports whether Total has been called.
+func (s *Statement) TotalCalled() bool {
+	return s.TotalCallCount() > 0
+}
+
+// TotalLastCall returns the parameters of the most recent call to Total,
+// and whether there has been one.
+func (s *Statement) TotalLastCall() (struct{}, bool) {
+	calls := s.TotalCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// TotalStub delegates its behavior to the field TotalStubStub.
+func (s *Statement) TotalStub() int {
+	s.totalStubCalls = append(s.totalStubCalls, struct{}{})
+	s.callLog = append(s.callLog, "TotalStub")
+	next := s.nextTotalStubReturns()
+	if s.TotalStubStub == nil && next == nil {
+		panic("Statement.TotalStub: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.TotalStubStub)()
+}
+
+// nextTotalStubReturns removes and returns the first of TotalStubReturns, or
+// nil if TotalStubStub is set or there are none left.
+func (s *Statement) nextTotalStubReturns() *struct{ R0 int } {
+	if s.TotalStubStub != nil {
+		return nil
+	}
+	if len(s.TotalStubReturns) == 0 {
+		return nil
+	}
+	next := s.TotalStubReturns[0]
+	s.TotalStubReturns = s.TotalStubReturns[1:]
+	return &next
+}
+
+// TotalStubCalls returns a slice of calls made to TotalStub. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TotalStubCalls() []struct{} {
+	return s.totalStubCalls
+}
+
+// TotalStubCallCount returns the number of calls made to TotalStub.
+func (s *Statement) TotalStubCallCount() int {
+	return len(s.totalStubCalls)
+}
+
+// TotalStubCalled reports whether TotalStub has been called.
+func (s *Statement) TotalStubCalled() bool {
+	return s.TotalStubCallCount() > 0
+}
+
+// TotalStubLastCall returns the parameters of the most recent call to TotalStub,
+// and whether there has been one.
+func (s *Statement) TotalStubLastCall() (struct{}, bool) {
+	calls := s.TotalStubCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Validate delegates its behavior to the field ValidateStub.
+func (s *Statement) Validate() error {
+	s.validateCalls = append(s.validateCalls, struct{}{})
+	s.callLog = append(s.callLog, "Validate")
+	next := s.nextValidateReturns()
+	if s.ValidateStub == nil && next == nil {
+		panic("Statement.Validate: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.ValidateStub)()
+}
+
+// nextValidateReturns removes and returns the first of ValidateReturns, or
+// nil if ValidateStub is set or there are none left.
+func (s *Statement) nextValidateReturns() *struct{ R0 error } {
+	if s.ValidateStub != nil {
+		return nil
+	}
+	if len(s.ValidateReturns) == 0 {
+		return nil
+	}
+	next := s.ValidateReturns[0]
+	s.ValidateReturns = s.ValidateReturns[1:]
+	return &next
+}
+
+// ValidateCalls returns a slice of calls made to Validate. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) ValidateCalls() []struct{} {
+	return s.validateCalls
+}
+
+// ValidateCallCount returns the number of calls made to Validate.
+func (s *Statement) ValidateCallCount() int {
+	return len(s.validateCalls)
+}
+
+// ValidateCalled reports whether Validate has been called.
+func (s *Statement) ValidateCalled() bool {
+	return s.ValidateCallCount() > 0
+}
+
+// ValidateLastCall returns the parameters of the most recent call to Validate,
+// and whether there has been one.
+func (s *Statement) ValidateLastCall() (struct{}, bool) {
+	calls := s.ValidateCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// CallLog_ returns the names of the methods called on s, in the order in
+// which they were called.
+func (s *Statement) CallLog_() []string {
+	return s.callLog
+}
+
+// AssertCallOrder reports an error to tb unless the methods called on s
+// were exactly methods, in that order.
+func (s *Statement) AssertCallOrder(tb testing.TB, methods ...string) {
+	tb.Helper()
+	calls := s.CallLog_()
+	if len(calls) != len(methods) {
+		tb.Errorf("Statement: got calls %q, want %q", calls, methods)
+		return
+	}
+	for i := range methods {
+		if calls[i] != methods[i] {
+			tb.Errorf("Statement: got calls %q, want %q", calls, methods)
+			return
+		}
+	}
+}
+
+// AssertCallSubsequence reports an error to tb unless methods were called on
+// s in that order, possibly interleaved with other calls.
+func (s *Statement) AssertCallSubsequence(tb testing.TB, methods ...string) {
+	tb.Helper()
+	calls := s.CallLog_()
+	i := 0
+	for _, method := range calls {
+		if i < len(methods) && method == methods[i] {
+			i++
+		}
+	}
+	if i < len(methods) {
+		tb.Errorf("Statement: got calls %q, want them to include %q in order", calls, methods)
+	}
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Statement) Reset() {
+	s.balanceCalls = nil
+	s.balanceCallCountCalls = nil
+	s.balanceCallsCalls = nil
+	s.callLogCalls = nil
+	s.cloneCalls = nil
+	s.marshalTextCalls = nil
+	s.sinkCalls = nil
+	s.tBCalls = nil
+	s.totalCalls = nil
+	s.totalStubCalls = nil
+	s.validateCalls = nil
+	s.callLog = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ ledgerclash.Statement = (*Statement)(nil)
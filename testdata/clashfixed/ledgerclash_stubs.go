@@ -0,0 +1,735 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package clashfixed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/dradtke/stubber/support"
+	"github.com/dradtke/stubber/testdata/ledgerclash"
+	"reflect"
+	"testing"
+)
+
+// Statement is a stubbed implementation of ledgerclash.Statement.
+type Statement struct {
+	// TB_, if set, is used to report calls to methods without a stub.
+	TB_ testing.TB
+	// Sink_, if set, is sent an event for every call made to the stub.
+	Sink_ func(support.CallEvent)
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int `stubber:"required"`
+	balanceCalls []struct{}
+	// BalanceCallCountStub defines the implementation for BalanceCallCount.
+	BalanceCallCountStub  func() int
+	balanceCallCountCalls []struct{}
+	// BalanceCallsStub defines the implementation for BalanceCalls.
+	BalanceCallsStub  func() []int
+	balanceCallsCalls []struct{}
+	// CallLogStub defines the implementation for CallLog.
+	CallLogStub  func() []string
+	callLogCalls []struct{}
+	// CloneStub defines the implementation for Clone.
+	CloneStub  func() ledgerclash.Statement
+	cloneCalls []struct{}
+	// MarshalTextStub defines the implementation for MarshalText.
+	MarshalTextStub  func() ([]byte, error)
+	marshalTextCalls []struct{}
+	// SinkStub defines the implementation for Sink.
+	SinkStub  func(entry string)
+	sinkCalls []struct{ Entry string }
+	// TBStub defines the implementation for TB.
+	TBStub  func() int
+	tBCalls []struct{}
+	// TotalStub_ defines the implementation for Total.
+	TotalStub_ func() int
+	totalCalls []struct{}
+	// TotalStubStub defines the implementation for TotalStub.
+	TotalStubStub  func() int
+	totalStubCalls []struct{}
+	// ValidateStub defines the implementation for Validate.
+	ValidateStub  func() error
+	validateCalls []struct{}
+}
+
+// NewStatement returns a new Statement without any stubs set.
+func NewStatement() *Statement {
+	return &Statement{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Statement) Balance() int {
+	if s.BalanceStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.Balance: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.Balance")
+		return 0
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "Balance", Args: s.balanceCalls[len(s.balanceCalls)-1]})
+	}
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls_ returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCalls_() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount_ returns the number of calls made to Balance.
+func (s *Statement) BalanceCallCount_() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Statement) BalanceCalled() bool {
+	return s.BalanceCallCount_() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Statement) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls_()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// BalanceCallCount delegates its behavior to the field BalanceCallCountStub.
+func (s *Statement) BalanceCallCount() int {
+	if s.BalanceCallCountStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.BalanceCallCount: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.BalanceCallCount")
+		return 0
+	}
+	s.balanceCallCountCalls = append(s.balanceCallCountCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "BalanceCallCount", Args: s.balanceCallCountCalls[len(s.balanceCallCountCalls)-1]})
+	}
+	return (s.BalanceCallCountStub)()
+}
+
+// BalanceCallCountCalls returns a slice of calls made to BalanceCallCount. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCallCountCalls() []struct{} {
+	return s.balanceCallCountCalls
+}
+
+// BalanceCallCountCallCount returns the number of calls made to BalanceCallCount.
+func (s *Statement) BalanceCallCountCallCount() int {
+	return len(s.balanceCallCountCalls)
+}
+
+// BalanceCallCountCalled reports whether BalanceCallCount has been called.
+func (s *Statement) BalanceCallCountCalled() bool {
+	return s.BalanceCallCountCallCount() > 0
+}
+
+// BalanceCallCountLastCall returns the parameters of the most recent call to BalanceCallCount,
+// and whether there has been one.
+func (s *Statement) BalanceCallCountLastCall() (struct{}, bool) {
+	calls := s.BalanceCallCountCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// BalanceCalls delegates its behavior to the field BalanceCallsStub.
+func (s *Statement) BalanceCalls() []int {
+	if s.BalanceCallsStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.BalanceCalls: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.BalanceCalls")
+		return nil
+	}
+	s.balanceCallsCalls = append(s.balanceCallsCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "BalanceCalls", Args: s.balanceCallsCalls[len(s.balanceCallsCalls)-1]})
+	}
+	return (s.BalanceCallsStub)()
+}
+
+// BalanceCallsCalls returns a slice of calls made to BalanceCalls. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) BalanceCallsCalls() []struct{} {
+	return s.balanceCallsCalls
+}
+
+// BalanceCallsCallCount returns the number of calls made to BalanceCalls.
+func (s *Statement) BalanceCallsCallCount() int {
+	return len(s.balanceCallsCalls)
+}
+
+// BalanceCallsCalled reports whether BalanceCalls has been called.
+func (s *Statement) BalanceCallsCalled() bool {
+	return s.BalanceCallsCallCount() > 0
+}
+
+// BalanceCallsLastCall returns the parameters of the most recent call to BalanceCalls,
+// and whether there has been one.
+func (s *Statement) BalanceCallsLastCall() (struct{}, bool) {
+	calls := s.BalanceCallsCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// CallLog delegates its behavior to the field CallLogStub.
+func (s *Statement) CallLog() []string {
+	if s.CallLogStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.CallLog: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.CallLog")
+		return nil
+	}
+	s.callLogCalls = append(s.callLogCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "CallLog", Args: s.callLogCalls[len(s.callLogCalls)-1]})
+	}
+	return (s.CallLogStub)()
+}
+
+// CallLogCalls returns a slice of calls made to CallLog. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) CallLogCalls() []struct{} {
+	return s.callLogCalls
+}
+
+// CallLogCallCount returns the number of calls made to CallLog.
+func (s *Statement) CallLogCallCount() int {
+	return len(s.callLogCalls)
+}
+
+// CallLogCalled reports whether CallLog has been called.
+func (s *Statement) CallLogCalled() bool {
+	return s.CallLogCallCount() > 0
+}
+
+// CallLogLastCall returns the parameters of the most recent call to CallLog,
+// and whether there has been one.
+func (s *Statement) CallLogLastCall() (struct{}, bool) {
+	calls := s.CallLogCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Clone delegates its behavior to the field CloneStub.
+func (s *Statement) Clone() ledgerclash.Statement {
+	if s.CloneStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.Clone: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.Clone")
+		return nil
+	}
+	s.cloneCalls = append(s.cloneCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "Clone", Args: s.cloneCalls[len(s.cloneCalls)-1]})
+	}
+	return (s.CloneStub)()
+}
+
+// CloneCalls returns a slice of calls made to Clone. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) CloneCalls() []struct{} {
+	return s.cloneCalls
+}
+
+// CloneCallCount returns the number of calls made to Clone.
+func (s *Statement) CloneCallCount() int {
+	return len(s.cloneCalls)
+}
+
+// CloneCalled reports whether Clone has been called.
+func (s *Statement) CloneCalled() bool {
+	return s.CloneCallCount() > 0
+}
+
+// CloneLastCall returns the parameters of the most recent call to Clone,
+// and whether there has been one.
+func (s *Statement) CloneLastCall() (struct{}, bool) {
+	calls := s.CloneCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// MarshalText delegates its behavior to the field MarshalTextStub.
+func (s *Statement) MarshalText() ([]byte, error) {
+	if s.MarshalTextStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.MarshalText: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.MarshalText")
+		return nil, nil
+	}
+	s.marshalTextCalls = append(s.marshalTextCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "MarshalText", Args: s.marshalTextCalls[len(s.marshalTextCalls)-1]})
+	}
+	return (s.MarshalTextStub)()
+}
+
+// MarshalTextCalls returns a slice of calls made to MarshalText. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) MarshalTextCalls() []struct{} {
+	return s.marshalTextCalls
+}
+
+// MarshalTextCallCount returns the number of calls made to MarshalText.
+func (s *Statement) MarshalTextCallCount() int {
+	return len(s.marshalTextCalls)
+}
+
+// MarshalTextCalled reports whether MarshalText has been called.
+func (s *Statement) MarshalTextCalled() bool {
+	return s.MarshalTextCallCount() > 0
+}
+
+// MarshalTextLastCall returns the parameters of the most recent call to MarshalText,
+// and whether there has been one.
+func (s *Statement) MarshalTextLastCall() (struct{}, bool) {
+	calls := s.MarshalTextCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Sink delegates its behavior to the field SinkStub.
+func (s *Statement) Sink(entry string) {
+	if s.SinkStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.Sink: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.Sink")
+		return
+	}
+	s.sinkCalls = append(s.sinkCalls, struct{ Entry string }{Entry: entry})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "Sink", Args: s.sinkCalls[len(s.sinkCalls)-1]})
+	}
+	(s.SinkStub)(entry)
+}
+
+// SinkCalls returns a slice of calls made to Sink. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) SinkCalls() []struct{ Entry string } {
+	return s.sinkCalls
+}
+
+// SinkCallCount returns the number of calls made to Sink.
+func (s *Statement) SinkCallCount() int {
+	return len(s.sinkCalls)
+}
+
+// SinkCalled reports whether Sink has been called.
+func (s *Statement) SinkCalled() bool {
+	return s.SinkCallCount() > 0
+}
+
+// SinkLastCall returns the parameters of the most recent call to Sink,
+// and whether there has been one.
+func (s *Statement) SinkLastCall() (struct{ Entry string }, bool) {
+	calls := s.SinkCalls()
+	if len(calls) == 0 {
+		return struct{ Entry string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// TB delegates its behavior to the field TBStub.
+func (s *Statement) TB() int {
+	if s.TBStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.TB: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.TB")
+		return 0
+	}
+	s.tBCalls = append(s.tBCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "TB", Args: s.tBCalls[len(s.tBCalls)-1]})
+	}
+	return (s.TBStub)()
+}
+
+// TBCalls returns a slice of calls made to TB. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TBCalls() []struct{} {
+	return s.tBCalls
+}
+
+// TBCallCount returns the number of calls made to TB.
+func (s *Statement) TBCallCount() int {
+	return len(s.tBCalls)
+}
+
+// TBCalled reports whether TB has been called.
+func (s *Statement) TBCalled() bool {
+	return s.TBCallCount() > 0
+}
+
+// TBLastCall returns the parameters of the most recent call to TB,
+// and whether there has been one.
+func (s *Statement) TBLastCall() (struct{}, bool) {
+	calls := s.TBCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Total delegates its behavior to the field TotalStub_.
+func (s *Statement) Total() int {
+	if s.TotalStub_ == nil {
+		if s.TB_ == nil {
+			panic("Statement.Total: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.Total")
+		return 0
+	}
+	s.totalCalls = append(s.totalCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "Total", Args: s.totalCalls[len(s.totalCalls)-1]})
+	}
+	return (s.TotalStub_)()
+}
+
+// TotalCalls returns a slice of calls made to Total. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TotalCalls() []struct{} {
+	return s.totalCalls
+}
+
+// TotalCallCount returns the number of calls made to Total.
+func (s *Statement) TotalCallCount() int {
+	return len(s.totalCalls)
+}
+
+// TotalCalled reports whether Total has been called.
+func (s *Statement) TotalCalled() bool {
+	return s.TotalCallCount() > 0
+}
+
+// TotalLastCall returns the parameters of the most recent call to Total,
+// and whether there has been one.
+func (s *Statement) TotalLastCall() (struct{}, bool) {
+	calls := s.TotalCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// TotalStub delegates its behavior to the field TotalStubStub.
+func (s *Statement) TotalStub() int {
+	if s.TotalStubStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.TotalStub: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.TotalStub")
+		return 0
+	}
+	s.totalStubCalls = append(s.totalStubCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "TotalStub", Args: s.totalStubCalls[len(s.totalStubCalls)-1]})
+	}
+	return (s.TotalStubStub)()
+}
+
+// TotalStubCalls returns a slice of calls made to TotalStub. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) TotalStubCalls() []struct{} {
+	return s.totalStubCalls
+}
+
+// TotalStubCallCount returns the number of calls made to TotalStub.
+func (s *Statement) TotalStubCallCount() int {
+	return len(s.totalStubCalls)
+}
+
+// TotalStubCalled reports whether TotalStub has been called.
+func (s *Statement) TotalStubCalled() bool {
+	return s.TotalStubCallCount() > 0
+}
+
+// TotalStubLastCall returns the parameters of the most recent call to TotalStub,
+// and whether there has been one.
+func (s *Statement) TotalStubLastCall() (struct{}, bool) {
+	calls := s.TotalStubCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Validate delegates its behavior to the field ValidateStub.
+func (s *Statement) Validate() error {
+	if s.ValidateStub == nil {
+		if s.TB_ == nil {
+			panic("Statement.Validate: nil method stub")
+		}
+		s.TB_.Helper()
+		s.TB_.Errorf("unexpected call to Statement.Validate")
+		return nil
+	}
+	s.validateCalls = append(s.validateCalls, struct{}{})
+	if s.Sink_ != nil {
+		s.Sink_(support.CallEvent{Stub: "Statement", Method: "Validate", Args: s.validateCalls[len(s.validateCalls)-1]})
+	}
+	return (s.ValidateStub)()
+}
+
+// ValidateCalls returns a slice of calls made to Validate. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statement) ValidateCalls() []struct{} {
+	return s.validateCalls
+}
+
+// ValidateCallCount returns the number of calls made to Validate.
+func (s *Statement) ValidateCallCount() int {
+	return len(s.validateCalls)
+}
+
+// ValidateCalled reports whether Validate has been called.
+func (s *Statement) ValidateCalled() bool {
+	return s.ValidateCallCount() > 0
+}
+
+// ValidateLastCall returns the parameters of the most recent call to Validate,
+// and whether there has been one.
+func (s *Statement) ValidateLastCall() (struct{}, bool) {
+	calls := s.ValidateCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Validate_ returns an error if any stub of s that is tagged as required is
+// nil.
+func (s *Statement) Validate_() error {
+	v := reflect.ValueOf(s).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Tag.Get("stubber") == "required" && v.Field(i).IsNil() {
+			return fmt.Errorf("Statement: required stub %s is nil", field.Name)
+		}
+	}
+	return nil
+}
+
+// AssertAllStubsUsed registers a cleanup with tb that reports an error for
+// each stub of s that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+func (s *Statement) AssertAllStubsUsed(tb testing.TB) {
+	tb.Cleanup(func() {
+		if s.BalanceStub != nil && len(s.balanceCalls) == 0 {
+			tb.Errorf("Statement: BalanceStub was set, but Balance was never called")
+		}
+		if s.BalanceCallCountStub != nil && len(s.balanceCallCountCalls) == 0 {
+			tb.Errorf("Statement: BalanceCallCountStub was set, but BalanceCallCount was never called")
+		}
+		if s.BalanceCallsStub != nil && len(s.balanceCallsCalls) == 0 {
+			tb.Errorf("Statement: BalanceCallsStub was set, but BalanceCalls was never called")
+		}
+		if s.CallLogStub != nil && len(s.callLogCalls) == 0 {
+			tb.Errorf("Statement: CallLogStub was set, but CallLog was never called")
+		}
+		if s.CloneStub != nil && len(s.cloneCalls) == 0 {
+			tb.Errorf("Statement: CloneStub was set, but Clone was never called")
+		}
+		if s.MarshalTextStub != nil && len(s.marshalTextCalls) == 0 {
+			tb.Errorf("Statement: MarshalTextStub was set, but MarshalText was never called")
+		}
+		if s.SinkStub != nil && len(s.sinkCalls) == 0 {
+			tb.Errorf("Statement: SinkStub was set, but Sink was never called")
+		}
+		if s.TBStub != nil && len(s.tBCalls) == 0 {
+			tb.Errorf("Statement: TBStub was set, but TB was never called")
+		}
+		if s.TotalStub_ != nil && len(s.totalCalls) == 0 {
+			tb.Errorf("Statement: TotalStub_ was set, but Total was never called")
+		}
+		if s.TotalStubStub != nil && len(s.totalStubCalls) == 0 {
+			tb.Errorf("Statement: TotalStubStub was set, but TotalStub was never called")
+		}
+		if s.ValidateStub != nil && len(s.validateCalls) == 0 {
+			tb.Errorf("Statement: ValidateStub was set, but Validate was never called")
+		}
+	})
+}
+
+// MarshalText_ encodes the calls made to s as text, with a line for each
+// call holding the method name and its parameters as JSON. Calls are grouped
+// by method so that the result is stable enough for snapshot tests.
+func (s *Statement) MarshalText_() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, call := range s.BalanceCalls_() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Balance ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.BalanceCallCountCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("BalanceCallCount ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.BalanceCallsCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("BalanceCalls ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.CallLogCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("CallLog ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.CloneCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Clone ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.MarshalTextCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("MarshalText ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.SinkCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Sink ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.TBCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("TB ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.TotalCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Total ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.TotalStubCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("TotalStub ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range s.ValidateCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Validate ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Statement) Reset() {
+	s.balanceCalls = nil
+	s.balanceCallCountCalls = nil
+	s.balanceCallsCalls = nil
+	s.callLogCalls = nil
+	s.cloneCalls = nil
+	s.marshalTextCalls = nil
+	s.sinkCalls = nil
+	s.tBCalls = nil
+	s.totalCalls = nil
+	s.totalStubCalls = nil
+	s.validateCalls = nil
+}
+
+// Clone_ returns a new Statement with the same stubs as s, but
+// without any recorded calls.
+func (s *Statement) Clone_() *Statement {
+	return &Statement{
+		TB_:                  s.TB_,
+		Sink_:                s.Sink_,
+		BalanceStub:          s.BalanceStub,
+		BalanceCallCountStub: s.BalanceCallCountStub,
+		BalanceCallsStub:     s.BalanceCallsStub,
+		CallLogStub:          s.CallLogStub,
+		CloneStub:            s.CloneStub,
+		MarshalTextStub:      s.MarshalTextStub,
+		SinkStub:             s.SinkStub,
+		TBStub:               s.TBStub,
+		TotalStub_:           s.TotalStub_,
+		TotalStubStub:        s.TotalStubStub,
+		ValidateStub:         s.ValidateStub,
+	}
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ ledgerclash.Statement = (*Statement)(nil)
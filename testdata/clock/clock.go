@@ -0,0 +1,6 @@
+package clock
+
+// Timer is declared alongside the package's code.
+type Timer interface {
+	Stop() bool
+}
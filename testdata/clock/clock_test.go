@@ -0,0 +1,9 @@
+package clock
+
+import "time"
+
+// Clock is only declared in a test file, so it can only be stubbed with
+// -tests, into a test file of this package.
+type Clock interface {
+	Now() time.Time
+}
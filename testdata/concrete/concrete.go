@@ -0,0 +1,22 @@
+package concrete
+
+// Mailer is a concrete type that no interface describes, so its method set
+// is stubbed instead. It has methods with both kinds of receiver, and an
+// unexported one that isn't stubbed.
+type Mailer struct {
+	host string
+}
+
+// Send sends body to addr.
+func (m *Mailer) Send(addr, body string) error {
+	return m.dial()
+}
+
+// Host returns the host of the mail server.
+func (m Mailer) Host() string {
+	return m.host
+}
+
+func (m *Mailer) dial() error {
+	return nil
+}
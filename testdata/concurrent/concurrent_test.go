@@ -0,0 +1,35 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+// quietTB ignores the errors reported to it.
+type quietTB struct {
+	testing.TB
+}
+
+func (quietTB) Helper()                       {}
+func (quietTB) Errorf(string, ...interface{}) {}
+
+func TestAssertWhileCalling(t *testing.T) {
+	s := &Account{BalanceStub: func() int { return 0 }}
+	s.Balance()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Balance()
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		s.AssertCallSubsequence(t, "Balance")
+		s.AssertCallOrder(quietTB{t}, "Balance")
+	}
+	wg.Wait()
+}
@@ -0,0 +1,18 @@
+package constraints
+
+// Ordered has only approximation elements.
+type Ordered interface {
+	~int | ~float64 | ~string
+}
+
+// Label has a method as well as a type term.
+type Label interface {
+	~string
+	Label() string
+}
+
+// Sorter is an ordinary interface whose methods use the constraints.
+type Sorter[T Ordered] interface {
+	Sort(items []T)
+	Less(a, b T) bool
+}
@@ -0,0 +1,18 @@
+package container
+
+import "fmt"
+
+// Container holds values that can be described as strings.
+type Container[T fmt.Stringer] interface {
+	// Add adds item to the container.
+	Add(item T) error
+	// First returns the first item in the container, and false if it's
+	// empty.
+	First() (T, bool)
+	Len() int
+}
+
+// Number is a constraint, which isn't stubbed since it can't be implemented.
+type Number interface {
+	~int | ~float64
+}
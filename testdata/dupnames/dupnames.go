@@ -0,0 +1,10 @@
+package dupnames
+
+// Index has parameters whose names only differ in case, which would record
+// to the same field if they weren't disambiguated. A result can't reuse a
+// parameter's name, e.g. Get(key string) (key string) doesn't compile, and
+// results aren't recorded, so result names never collide.
+type Index interface {
+	Get(key string, Key int) (value string, err error)
+	Move(url, URL string, url2 bool)
+}
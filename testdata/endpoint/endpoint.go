@@ -0,0 +1,14 @@
+package endpoint
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// Endpoint's methods refer to other packages only through the parameters and
+// results of the functions they return.
+type Endpoint interface {
+	Handler() func(w http.ResponseWriter, r *http.Request)
+	Dialer() func(ctx context.Context, network, addr string) (conn net.Conn, err error)
+}
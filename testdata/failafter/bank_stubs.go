@@ -0,0 +1,574 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package failafter
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// BalanceReturns holds results returned, in order, by calls to Balance
+	// while BalanceStub is nil. Each one is removed once it's returned.
+	BalanceReturns []struct{ R0 int }
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// CloseReturns holds results returned, in order, by calls to Close
+	// while CloseStub is nil. Each one is removed once it's returned.
+	CloseReturns []struct{ R0 error }
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SetNicknameValidate, if set, is called with the arguments of each call to
+	// SetNickname before its stub. A non-nil error is raised as a panic.
+	SetNicknameValidate func(_s string) error
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// SummarizeValidate, if set, is called with the arguments of each call to
+	// Summarize before its stub. A non-nil error is raised as a panic.
+	SummarizeValidate func(w io.Writer) error
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Account) Balance() int {
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	next := s.nextBalanceReturns()
+	if s.BalanceStub == nil && next == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.BalanceStub)()
+}
+
+// nextBalanceReturns removes and returns the first of BalanceReturns, or
+// nil if BalanceStub is set or there are none left.
+func (s *Account) nextBalanceReturns() *struct{ R0 int } {
+	if s.BalanceStub != nil {
+		return nil
+	}
+	if len(s.BalanceReturns) == 0 {
+		return nil
+	}
+	next := s.BalanceReturns[0]
+	s.BalanceReturns = s.BalanceReturns[1:]
+	return &next
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *Account) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Account) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Account) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Account) Close() error {
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	if s.CloseFailAfter > 0 && len(s.closeCalls) > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	next := s.nextCloseReturns()
+	if s.CloseStub == nil && next == nil {
+		panic("Account.Close: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.CloseStub)()
+}
+
+// nextCloseReturns removes and returns the first of CloseReturns, or
+// nil if CloseStub is set or there are none left.
+func (s *Account) nextCloseReturns() *struct{ R0 error } {
+	if s.CloseStub != nil {
+		return nil
+	}
+	if len(s.CloseReturns) == 0 {
+		return nil
+	}
+	next := s.CloseReturns[0]
+	s.CloseReturns = s.CloseReturns[1:]
+	return &next
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Account) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Account) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Account) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	if s.SetNicknameValidate != nil {
+		if err := s.SetNicknameValidate(_s); err != nil {
+			panic(err)
+		}
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *Account) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Account) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	if s.SummarizeValidate != nil {
+		if err := s.SummarizeValidate(w); err != nil {
+			panic(err)
+		}
+	}
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *Account) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Account) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Account) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// BalanceReturns holds results returned, in order, by calls to Balance
+	// while BalanceStub is nil. Each one is removed once it's returned.
+	BalanceReturns []struct{ R0 int }
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// CloseReturns holds results returned, in order, by calls to Close
+	// while CloseStub is nil. Each one is removed once it's returned.
+	CloseReturns []struct{ R0 error }
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SetNicknameValidate, if set, is called with the arguments of each call to
+	// SetNickname before its stub. A non-nil error is raised as a panic.
+	SetNicknameValidate func(_s string) error
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// SummarizeValidate, if set, is called with the arguments of each call to
+	// Summarize before its stub. A non-nil error is raised as a panic.
+	SummarizeValidate func(w io.Writer) error
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+	// WithdrawFailAfter, if positive, is the number of calls to Withdraw that
+	// succeed before it starts failing with WithdrawFailError.
+	WithdrawFailAfter int
+	// WithdrawFailError is the error returned by Withdraw once
+	// WithdrawFailAfter calls have been made.
+	WithdrawFailError error
+	// WithdrawValidate, if set, is called with the arguments of each call to
+	// Withdraw before its stub. A non-nil error is returned by Withdraw.
+	WithdrawValidate func(amount int) error
+	// WithdrawReturns holds results returned, in order, by calls to Withdraw
+	// while WithdrawStub is nil. Each one is removed once it's returned.
+	WithdrawReturns []struct {
+		R0 int
+		R1 error
+	}
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *WithdrawableAccount) Balance() int {
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	next := s.nextBalanceReturns()
+	if s.BalanceStub == nil && next == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.BalanceStub)()
+}
+
+// nextBalanceReturns removes and returns the first of BalanceReturns, or
+// nil if BalanceStub is set or there are none left.
+func (s *WithdrawableAccount) nextBalanceReturns() *struct{ R0 int } {
+	if s.BalanceStub != nil {
+		return nil
+	}
+	if len(s.BalanceReturns) == 0 {
+		return nil
+	}
+	next := s.BalanceReturns[0]
+	s.BalanceReturns = s.BalanceReturns[1:]
+	return &next
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *WithdrawableAccount) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *WithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *WithdrawableAccount) Close() error {
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	if s.CloseFailAfter > 0 && len(s.closeCalls) > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	next := s.nextCloseReturns()
+	if s.CloseStub == nil && next == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (s.CloseStub)()
+}
+
+// nextCloseReturns removes and returns the first of CloseReturns, or
+// nil if CloseStub is set or there are none left.
+func (s *WithdrawableAccount) nextCloseReturns() *struct{ R0 error } {
+	if s.CloseStub != nil {
+		return nil
+	}
+	if len(s.CloseReturns) == 0 {
+		return nil
+	}
+	next := s.CloseReturns[0]
+	s.CloseReturns = s.CloseReturns[1:]
+	return &next
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *WithdrawableAccount) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *WithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	if s.SetNicknameValidate != nil {
+		if err := s.SetNicknameValidate(_s); err != nil {
+			panic(err)
+		}
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *WithdrawableAccount) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *WithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	if s.SummarizeValidate != nil {
+		if err := s.SummarizeValidate(w); err != nil {
+			panic(err)
+		}
+	}
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *WithdrawableAccount) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *WithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	s.withdrawCalls = append(s.withdrawCalls, struct{ Amount int }{Amount: amount})
+	if s.WithdrawValidate != nil {
+		if err := s.WithdrawValidate(amount); err != nil {
+			return 0, err
+		}
+	}
+	if s.WithdrawFailAfter > 0 && len(s.withdrawCalls) > s.WithdrawFailAfter {
+		return 0, s.WithdrawFailError
+	}
+	next := s.nextWithdrawReturns()
+	if s.WithdrawStub == nil && next == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	if next != nil {
+		return next.R0, next.R1
+	}
+	return (s.WithdrawStub)(amount)
+}
+
+// nextWithdrawReturns removes and returns the first of WithdrawReturns, or
+// nil if WithdrawStub is set or there are none left.
+func (s *WithdrawableAccount) nextWithdrawReturns() *struct {
+	R0 int
+	R1 error
+} {
+	if s.WithdrawStub != nil {
+		return nil
+	}
+	if len(s.WithdrawReturns) == 0 {
+		return nil
+	}
+	next := s.WithdrawReturns[0]
+	s.WithdrawReturns = s.WithdrawReturns[1:]
+	return &next
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return s.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (s *WithdrawableAccount) WithdrawCallCount() int {
+	return len(s.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *WithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *WithdrawableAccount) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+	s.withdrawCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
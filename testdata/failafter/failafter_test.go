@@ -0,0 +1,58 @@
+package failafter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFailAfterUnsetStub(t *testing.T) {
+	errClosed := errors.New("closed")
+	s := &Account{CloseFailAfter: 1, CloseFailError: errClosed}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("call within CloseFailAfter didn't panic without a stub")
+			}
+		}()
+		s.Close()
+	}()
+	if err := s.Close(); err != errClosed {
+		t.Errorf("got error %v, want %v", err, errClosed)
+	}
+	if n := s.CloseCallCount(); n != 2 {
+		t.Errorf("got %d calls, want 2", n)
+	}
+}
+
+func TestRejectedCallsKeepReturns(t *testing.T) {
+	errClosed := errors.New("closed")
+	errNegative := errors.New("negative amount")
+	s := &WithdrawableAccount{
+		WithdrawFailAfter: 2,
+		WithdrawFailError: errClosed,
+		WithdrawValidate: func(amount int) error {
+			if amount < 0 {
+				return errNegative
+			}
+			return nil
+		},
+		WithdrawReturns: []struct {
+			R0 int
+			R1 error
+		}{{R0: 10}, {R0: 20}},
+	}
+
+	if _, err := s.Withdraw(-1); err != errNegative {
+		t.Errorf("got error %v, want %v", err, errNegative)
+	}
+	if n, err := s.Withdraw(5); n != 10 || err != nil {
+		t.Errorf("got (%d, %v), want (10, nil)", n, err)
+	}
+	if _, err := s.Withdraw(5); err != errClosed {
+		t.Errorf("got error %v, want %v", err, errClosed)
+	}
+	if n := len(s.WithdrawReturns); n != 1 {
+		t.Errorf("got %d queued results left, want 1", n)
+	}
+}
@@ -0,0 +1,23 @@
+package generic
+
+import "time"
+
+type List[T any] struct {
+	Items []T
+}
+
+type Item struct {
+	Name string
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Service's parameters instantiate generic types from this package, one of
+// them with a type argument from another package.
+type Service interface {
+	Process(items List[Item]) error
+	Latest() (Pair[string, time.Time], bool)
+}
@@ -0,0 +1,749 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package gomock
+
+import (
+	"github.com/dradtke/stubber/support"
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	recorder *AccountRecorder
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Account) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *Account) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Account) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Account) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Account) Close() error {
+	if s.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Account) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Account) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Account) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *Account) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Account) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *Account) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *Account) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Account) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Account) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+}
+
+// EXPECT returns a recorder for setting the stubs of s from expected
+// calls, in the style of gomock. A call that doesn't match one of its
+// method's expected calls panics.
+func (s *Account) EXPECT() *AccountRecorder {
+	if s.recorder == nil {
+		s.recorder = &AccountRecorder{stub: s}
+	}
+	return s.recorder
+}
+
+// AccountRecorder sets the stubs of its Account from expected calls.
+type AccountRecorder struct {
+	stub                *Account
+	expectedBalance     support.Expectations[struct{ R0 int }]
+	expectedClose       support.Expectations[struct{ R0 error }]
+	expectedSetNickname support.Expectations[struct{}]
+	expectedSummarize   support.Expectations[struct{}]
+}
+
+// Balance expects a call to Balance with arguments matching the given ones,
+// which are either values or support.Matchers, and sets BalanceStub.
+func (s *AccountRecorder) Balance() *AccountBalanceCall {
+	s.stub.BalanceStub = func() int {
+		results := s.expectedBalance.Call("Account.Balance")
+		return results.R0
+	}
+	return &AccountBalanceCall{s.expectedBalance.Expect()}
+}
+
+// AccountBalanceCall is an expected call to Account.Balance.
+type AccountBalanceCall struct {
+	expectation *support.Expectation[struct{ R0 int }]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *AccountBalanceCall) Return(r0 int) *AccountBalanceCall {
+	s.expectation.Return(struct{ R0 int }{r0})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *AccountBalanceCall) Times(n int) *AccountBalanceCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *AccountBalanceCall) AnyTimes() *AccountBalanceCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Close expects a call to Close with arguments matching the given ones,
+// which are either values or support.Matchers, and sets CloseStub.
+func (s *AccountRecorder) Close() *AccountCloseCall {
+	s.stub.CloseStub = func() error {
+		results := s.expectedClose.Call("Account.Close")
+		return results.R0
+	}
+	return &AccountCloseCall{s.expectedClose.Expect()}
+}
+
+// AccountCloseCall is an expected call to Account.Close.
+type AccountCloseCall struct {
+	expectation *support.Expectation[struct{ R0 error }]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *AccountCloseCall) Return(r0 error) *AccountCloseCall {
+	s.expectation.Return(struct{ R0 error }{r0})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *AccountCloseCall) Times(n int) *AccountCloseCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *AccountCloseCall) AnyTimes() *AccountCloseCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// SetNickname expects a call to SetNickname with arguments matching the given ones,
+// which are either values or support.Matchers, and sets SetNicknameStub.
+func (s *AccountRecorder) SetNickname(_s interface{}) *AccountSetNicknameCall {
+	s.stub.SetNicknameStub = func(_s string) {
+		s.expectedSetNickname.Call("Account.SetNickname", _s)
+	}
+	return &AccountSetNicknameCall{s.expectedSetNickname.Expect(_s)}
+}
+
+// AccountSetNicknameCall is an expected call to Account.SetNickname.
+type AccountSetNicknameCall struct {
+	expectation *support.Expectation[struct{}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *AccountSetNicknameCall) Return() *AccountSetNicknameCall {
+	s.expectation.Return(struct{}{})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *AccountSetNicknameCall) Times(n int) *AccountSetNicknameCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *AccountSetNicknameCall) AnyTimes() *AccountSetNicknameCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Summarize expects a call to Summarize with arguments matching the given ones,
+// which are either values or support.Matchers, and sets SummarizeStub.
+func (s *AccountRecorder) Summarize(w interface{}) *AccountSummarizeCall {
+	s.stub.SummarizeStub = func(w io.Writer) {
+		s.expectedSummarize.Call("Account.Summarize", w)
+	}
+	return &AccountSummarizeCall{s.expectedSummarize.Expect(w)}
+}
+
+// AccountSummarizeCall is an expected call to Account.Summarize.
+type AccountSummarizeCall struct {
+	expectation *support.Expectation[struct{}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *AccountSummarizeCall) Return() *AccountSummarizeCall {
+	s.expectation.Return(struct{}{})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *AccountSummarizeCall) Times(n int) *AccountSummarizeCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *AccountSummarizeCall) AnyTimes() *AccountSummarizeCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	recorder *WithdrawableAccountRecorder
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *WithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *WithdrawableAccount) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *WithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *WithdrawableAccount) Close() error {
+	if s.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *WithdrawableAccount) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *WithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *WithdrawableAccount) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *WithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *WithdrawableAccount) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *WithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if s.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	s.withdrawCalls = append(s.withdrawCalls, struct{ Amount int }{Amount: amount})
+	return (s.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (s *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return s.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (s *WithdrawableAccount) WithdrawCallCount() int {
+	return len(s.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *WithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *WithdrawableAccount) Reset() {
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+	s.withdrawCalls = nil
+}
+
+// EXPECT returns a recorder for setting the stubs of s from expected
+// calls, in the style of gomock. A call that doesn't match one of its
+// method's expected calls panics.
+func (s *WithdrawableAccount) EXPECT() *WithdrawableAccountRecorder {
+	if s.recorder == nil {
+		s.recorder = &WithdrawableAccountRecorder{stub: s}
+	}
+	return s.recorder
+}
+
+// WithdrawableAccountRecorder sets the stubs of its WithdrawableAccount from expected calls.
+type WithdrawableAccountRecorder struct {
+	stub                *WithdrawableAccount
+	expectedBalance     support.Expectations[struct{ R0 int }]
+	expectedClose       support.Expectations[struct{ R0 error }]
+	expectedSetNickname support.Expectations[struct{}]
+	expectedSummarize   support.Expectations[struct{}]
+	expectedWithdraw    support.Expectations[struct {
+		R0 int
+		R1 error
+	}]
+}
+
+// Balance expects a call to Balance with arguments matching the given ones,
+// which are either values or support.Matchers, and sets BalanceStub.
+func (s *WithdrawableAccountRecorder) Balance() *WithdrawableAccountBalanceCall {
+	s.stub.BalanceStub = func() int {
+		results := s.expectedBalance.Call("WithdrawableAccount.Balance")
+		return results.R0
+	}
+	return &WithdrawableAccountBalanceCall{s.expectedBalance.Expect()}
+}
+
+// WithdrawableAccountBalanceCall is an expected call to WithdrawableAccount.Balance.
+type WithdrawableAccountBalanceCall struct {
+	expectation *support.Expectation[struct{ R0 int }]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *WithdrawableAccountBalanceCall) Return(r0 int) *WithdrawableAccountBalanceCall {
+	s.expectation.Return(struct{ R0 int }{r0})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *WithdrawableAccountBalanceCall) Times(n int) *WithdrawableAccountBalanceCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *WithdrawableAccountBalanceCall) AnyTimes() *WithdrawableAccountBalanceCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Close expects a call to Close with arguments matching the given ones,
+// which are either values or support.Matchers, and sets CloseStub.
+func (s *WithdrawableAccountRecorder) Close() *WithdrawableAccountCloseCall {
+	s.stub.CloseStub = func() error {
+		results := s.expectedClose.Call("WithdrawableAccount.Close")
+		return results.R0
+	}
+	return &WithdrawableAccountCloseCall{s.expectedClose.Expect()}
+}
+
+// WithdrawableAccountCloseCall is an expected call to WithdrawableAccount.Close.
+type WithdrawableAccountCloseCall struct {
+	expectation *support.Expectation[struct{ R0 error }]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *WithdrawableAccountCloseCall) Return(r0 error) *WithdrawableAccountCloseCall {
+	s.expectation.Return(struct{ R0 error }{r0})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *WithdrawableAccountCloseCall) Times(n int) *WithdrawableAccountCloseCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *WithdrawableAccountCloseCall) AnyTimes() *WithdrawableAccountCloseCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// SetNickname expects a call to SetNickname with arguments matching the given ones,
+// which are either values or support.Matchers, and sets SetNicknameStub.
+func (s *WithdrawableAccountRecorder) SetNickname(_s interface{}) *WithdrawableAccountSetNicknameCall {
+	s.stub.SetNicknameStub = func(_s string) {
+		s.expectedSetNickname.Call("WithdrawableAccount.SetNickname", _s)
+	}
+	return &WithdrawableAccountSetNicknameCall{s.expectedSetNickname.Expect(_s)}
+}
+
+// WithdrawableAccountSetNicknameCall is an expected call to WithdrawableAccount.SetNickname.
+type WithdrawableAccountSetNicknameCall struct {
+	expectation *support.Expectation[struct{}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *WithdrawableAccountSetNicknameCall) Return() *WithdrawableAccountSetNicknameCall {
+	s.expectation.Return(struct{}{})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *WithdrawableAccountSetNicknameCall) Times(n int) *WithdrawableAccountSetNicknameCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *WithdrawableAccountSetNicknameCall) AnyTimes() *WithdrawableAccountSetNicknameCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Summarize expects a call to Summarize with arguments matching the given ones,
+// which are either values or support.Matchers, and sets SummarizeStub.
+func (s *WithdrawableAccountRecorder) Summarize(w interface{}) *WithdrawableAccountSummarizeCall {
+	s.stub.SummarizeStub = func(w io.Writer) {
+		s.expectedSummarize.Call("WithdrawableAccount.Summarize", w)
+	}
+	return &WithdrawableAccountSummarizeCall{s.expectedSummarize.Expect(w)}
+}
+
+// WithdrawableAccountSummarizeCall is an expected call to WithdrawableAccount.Summarize.
+type WithdrawableAccountSummarizeCall struct {
+	expectation *support.Expectation[struct{}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *WithdrawableAccountSummarizeCall) Return() *WithdrawableAccountSummarizeCall {
+	s.expectation.Return(struct{}{})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *WithdrawableAccountSummarizeCall) Times(n int) *WithdrawableAccountSummarizeCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *WithdrawableAccountSummarizeCall) AnyTimes() *WithdrawableAccountSummarizeCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Withdraw expects a call to Withdraw with arguments matching the given ones,
+// which are either values or support.Matchers, and sets WithdrawStub.
+func (s *WithdrawableAccountRecorder) Withdraw(amount interface{}) *WithdrawableAccountWithdrawCall {
+	s.stub.WithdrawStub = func(amount int) (int, error) {
+		results := s.expectedWithdraw.Call("WithdrawableAccount.Withdraw", amount)
+		return results.R0, results.R1
+	}
+	return &WithdrawableAccountWithdrawCall{s.expectedWithdraw.Expect(amount)}
+}
+
+// WithdrawableAccountWithdrawCall is an expected call to WithdrawableAccount.Withdraw.
+type WithdrawableAccountWithdrawCall struct {
+	expectation *support.Expectation[struct {
+		R0 int
+		R1 error
+	}]
+}
+
+// Return sets the results of the call, which are zero values by default.
+func (s *WithdrawableAccountWithdrawCall) Return(r0 int, r1 error) *WithdrawableAccountWithdrawCall {
+	s.expectation.Return(struct {
+		R0 int
+		R1 error
+	}{r0, r1})
+	return s
+}
+
+// Times sets the number of times the call is expected, which is once by
+// default.
+func (s *WithdrawableAccountWithdrawCall) Times(n int) *WithdrawableAccountWithdrawCall {
+	s.expectation.Times(n)
+	return s
+}
+
+// AnyTimes expects the call any number of times.
+func (s *WithdrawableAccountWithdrawCall) AnyTimes() *WithdrawableAccountWithdrawCall {
+	s.expectation.AnyTimes()
+	return s
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
@@ -0,0 +1,12 @@
+package ignore
+
+// Pager's Page method is ignored, so its stub only has Notify and doesn't
+// implement Pager.
+type Pager interface {
+	Notify(msg string) error
+	// Page is replaced by Notify.
+	//
+	// stubber:ignore
+	Page(msg string) error
+	Ack(id int) //stubber:ignore
+}
@@ -0,0 +1,16 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/dradtke/stubber/testdata/bank"
+)
+
+// Ledger declares its methods out of order, and mixes in the methods of an
+// embedded interface from another package.
+type Ledger interface {
+	Record(account bank.Account, amount int) error
+	Entries(since time.Time) []string
+	bank.Account
+	Audit() error
+}
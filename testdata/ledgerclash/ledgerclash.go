@@ -0,0 +1,19 @@
+package ledgerclash
+
+import "encoding"
+
+// Statement has methods named like the helpers generated for its others.
+type Statement interface {
+	// MarshalText is named like the method generated with -marshal.
+	encoding.TextMarshaler
+	Balance() int
+	BalanceCalls() []int
+	BalanceCallCount() int
+	Total() int
+	TotalStub() int
+	CallLog() []string
+	Clone() Statement
+	Validate() error
+	Sink(entry string)
+	TB() int
+}
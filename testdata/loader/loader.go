@@ -0,0 +1,7 @@
+package loader
+
+// Loader has a method named like the stub field of another.
+type Loader interface {
+	Load() error
+	LoadStub() error
+}
@@ -0,0 +1,10 @@
+package locker
+
+import "sync"
+
+// LockedCache embeds a standard library interface whose methods don't refer to
+// package sync, so the stubs shouldn't import it.
+type LockedCache interface {
+	sync.Locker
+	Get(key string) (interface{}, bool)
+}
@@ -0,0 +1,7 @@
+package logger
+
+type Logger interface {
+	Printf(format string, args ...interface{})
+	Println(args ...interface{})
+	Enabled(level int) bool
+}
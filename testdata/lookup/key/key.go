@@ -0,0 +1,4 @@
+package key
+
+// K is a lookup key.
+type K string
@@ -0,0 +1,13 @@
+package lookup
+
+import (
+	"github.com/dradtke/stubber/testdata/lookup/key"
+	"github.com/dradtke/stubber/testdata/lookup/value"
+)
+
+// Lookup's methods only refer to key and value inside composite types.
+type Lookup interface {
+	Recent() [3]key.K
+	Watch(updates <-chan value.V)
+	Describe(entry struct{ Key key.K }) interface{ Get() value.V }
+}
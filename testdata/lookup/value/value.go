@@ -0,0 +1,6 @@
+package value
+
+// V is a looked up value.
+type V struct {
+	Data []byte
+}
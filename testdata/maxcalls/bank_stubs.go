@@ -0,0 +1,594 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package maxcalls
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub      func() int
+	balanceCalls     []struct{}
+	balanceCallTotal int
+	// CloseStub defines the implementation for Close.
+	CloseStub      func() error
+	closeCalls     []struct{}
+	closeCallTotal int
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub      func(_s string)
+	setNicknameCalls     []struct{ S string }
+	setNicknameCallTotal int
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub      func(w io.Writer)
+	summarizeCalls     []struct{ W io.Writer }
+	summarizeCallTotal int
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Account) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	if s.balanceCalls == nil {
+		s.balanceCalls = make([]struct{}, 0, 3)
+	}
+	if len(s.balanceCalls) < 3 {
+		s.balanceCalls = append(s.balanceCalls, struct{}{})
+	} else {
+		s.balanceCalls[s.balanceCallTotal%3] = struct{}{}
+	}
+	s.balanceCallTotal++
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *Account) BalanceCalls() []struct{} {
+	if len(s.balanceCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.balanceCallTotal % len(s.balanceCalls)
+	calls := make([]struct{}, 0, len(s.balanceCalls))
+	calls = append(calls, s.balanceCalls[start:]...)
+	return append(calls, s.balanceCalls[:start]...)
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+// It includes the calls that are no longer kept.
+func (s *Account) BalanceCallCount() int {
+	return s.balanceCallTotal
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Account) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Account) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Account) Close() error {
+	if s.closeCalls == nil {
+		s.closeCalls = make([]struct{}, 0, 3)
+	}
+	if len(s.closeCalls) < 3 {
+		s.closeCalls = append(s.closeCalls, struct{}{})
+	} else {
+		s.closeCalls[s.closeCallTotal%3] = struct{}{}
+	}
+	s.closeCallTotal++
+	if s.CloseFailAfter > 0 && s.closeCallTotal > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	if s.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *Account) CloseCalls() []struct{} {
+	if len(s.closeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.closeCallTotal % len(s.closeCalls)
+	calls := make([]struct{}, 0, len(s.closeCalls))
+	calls = append(calls, s.closeCalls[start:]...)
+	return append(calls, s.closeCalls[:start]...)
+}
+
+// CloseCallCount returns the number of calls made to Close.
+// It includes the calls that are no longer kept.
+func (s *Account) CloseCallCount() int {
+	return s.closeCallTotal
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Account) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Account) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	if s.setNicknameCalls == nil {
+		s.setNicknameCalls = make([]struct{ S string }, 0, 3)
+	}
+	if len(s.setNicknameCalls) < 3 {
+		s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	} else {
+		s.setNicknameCalls[s.setNicknameCallTotal%3] = struct{ S string }{S: _s}
+	}
+	s.setNicknameCallTotal++
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *Account) SetNicknameCalls() []struct{ S string } {
+	if len(s.setNicknameCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.setNicknameCallTotal % len(s.setNicknameCalls)
+	calls := make([]struct{ S string }, 0, len(s.setNicknameCalls))
+	calls = append(calls, s.setNicknameCalls[start:]...)
+	return append(calls, s.setNicknameCalls[:start]...)
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+// It includes the calls that are no longer kept.
+func (s *Account) SetNicknameCallCount() int {
+	return s.setNicknameCallTotal
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Account) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	if s.summarizeCalls == nil {
+		s.summarizeCalls = make([]struct{ W io.Writer }, 0, 3)
+	}
+	if len(s.summarizeCalls) < 3 {
+		s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	} else {
+		s.summarizeCalls[s.summarizeCallTotal%3] = struct{ W io.Writer }{W: w}
+	}
+	s.summarizeCallTotal++
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *Account) SummarizeCalls() []struct{ W io.Writer } {
+	if len(s.summarizeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.summarizeCallTotal % len(s.summarizeCalls)
+	calls := make([]struct{ W io.Writer }, 0, len(s.summarizeCalls))
+	calls = append(calls, s.summarizeCalls[start:]...)
+	return append(calls, s.summarizeCalls[:start]...)
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+// It includes the calls that are no longer kept.
+func (s *Account) SummarizeCallCount() int {
+	return s.summarizeCallTotal
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Account) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Account) Reset() {
+	s.balanceCalls = nil
+	s.balanceCallTotal = 0
+	s.closeCalls = nil
+	s.closeCallTotal = 0
+	s.setNicknameCalls = nil
+	s.setNicknameCallTotal = 0
+	s.summarizeCalls = nil
+	s.summarizeCallTotal = 0
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub      func() int
+	balanceCalls     []struct{}
+	balanceCallTotal int
+	// CloseStub defines the implementation for Close.
+	CloseStub      func() error
+	closeCalls     []struct{}
+	closeCallTotal int
+	// CloseFailAfter, if positive, is the number of calls to Close that
+	// succeed before it starts failing with CloseFailError.
+	CloseFailAfter int
+	// CloseFailError is the error returned by Close once
+	// CloseFailAfter calls have been made.
+	CloseFailError error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub      func(_s string)
+	setNicknameCalls     []struct{ S string }
+	setNicknameCallTotal int
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub      func(w io.Writer)
+	summarizeCalls     []struct{ W io.Writer }
+	summarizeCallTotal int
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub      func(amount int) (int, error)
+	withdrawCalls     []struct{ Amount int }
+	withdrawCallTotal int
+	// WithdrawFailAfter, if positive, is the number of calls to Withdraw that
+	// succeed before it starts failing with WithdrawFailError.
+	WithdrawFailAfter int
+	// WithdrawFailError is the error returned by Withdraw once
+	// WithdrawFailAfter calls have been made.
+	WithdrawFailError error
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *WithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	if s.balanceCalls == nil {
+		s.balanceCalls = make([]struct{}, 0, 3)
+	}
+	if len(s.balanceCalls) < 3 {
+		s.balanceCalls = append(s.balanceCalls, struct{}{})
+	} else {
+		s.balanceCalls[s.balanceCallTotal%3] = struct{}{}
+	}
+	s.balanceCallTotal++
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *WithdrawableAccount) BalanceCalls() []struct{} {
+	if len(s.balanceCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.balanceCallTotal % len(s.balanceCalls)
+	calls := make([]struct{}, 0, len(s.balanceCalls))
+	calls = append(calls, s.balanceCalls[start:]...)
+	return append(calls, s.balanceCalls[:start]...)
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+// It includes the calls that are no longer kept.
+func (s *WithdrawableAccount) BalanceCallCount() int {
+	return s.balanceCallTotal
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *WithdrawableAccount) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *WithdrawableAccount) Close() error {
+	if s.closeCalls == nil {
+		s.closeCalls = make([]struct{}, 0, 3)
+	}
+	if len(s.closeCalls) < 3 {
+		s.closeCalls = append(s.closeCalls, struct{}{})
+	} else {
+		s.closeCalls[s.closeCallTotal%3] = struct{}{}
+	}
+	s.closeCallTotal++
+	if s.CloseFailAfter > 0 && s.closeCallTotal > s.CloseFailAfter {
+		return s.CloseFailError
+	}
+	if s.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *WithdrawableAccount) CloseCalls() []struct{} {
+	if len(s.closeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.closeCallTotal % len(s.closeCalls)
+	calls := make([]struct{}, 0, len(s.closeCalls))
+	calls = append(calls, s.closeCalls[start:]...)
+	return append(calls, s.closeCalls[:start]...)
+}
+
+// CloseCallCount returns the number of calls made to Close.
+// It includes the calls that are no longer kept.
+func (s *WithdrawableAccount) CloseCallCount() int {
+	return s.closeCallTotal
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *WithdrawableAccount) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	if s.setNicknameCalls == nil {
+		s.setNicknameCalls = make([]struct{ S string }, 0, 3)
+	}
+	if len(s.setNicknameCalls) < 3 {
+		s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	} else {
+		s.setNicknameCalls[s.setNicknameCallTotal%3] = struct{ S string }{S: _s}
+	}
+	s.setNicknameCallTotal++
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	if len(s.setNicknameCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.setNicknameCallTotal % len(s.setNicknameCalls)
+	calls := make([]struct{ S string }, 0, len(s.setNicknameCalls))
+	calls = append(calls, s.setNicknameCalls[start:]...)
+	return append(calls, s.setNicknameCalls[:start]...)
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+// It includes the calls that are no longer kept.
+func (s *WithdrawableAccount) SetNicknameCallCount() int {
+	return s.setNicknameCallTotal
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *WithdrawableAccount) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	if s.summarizeCalls == nil {
+		s.summarizeCalls = make([]struct{ W io.Writer }, 0, 3)
+	}
+	if len(s.summarizeCalls) < 3 {
+		s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	} else {
+		s.summarizeCalls[s.summarizeCallTotal%3] = struct{ W io.Writer }{W: w}
+	}
+	s.summarizeCallTotal++
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	if len(s.summarizeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.summarizeCallTotal % len(s.summarizeCalls)
+	calls := make([]struct{ W io.Writer }, 0, len(s.summarizeCalls))
+	calls = append(calls, s.summarizeCalls[start:]...)
+	return append(calls, s.summarizeCalls[:start]...)
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+// It includes the calls that are no longer kept.
+func (s *WithdrawableAccount) SummarizeCallCount() int {
+	return s.summarizeCallTotal
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *WithdrawableAccount) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if s.withdrawCalls == nil {
+		s.withdrawCalls = make([]struct{ Amount int }, 0, 3)
+	}
+	if len(s.withdrawCalls) < 3 {
+		s.withdrawCalls = append(s.withdrawCalls, struct{ Amount int }{Amount: amount})
+	} else {
+		s.withdrawCalls[s.withdrawCallTotal%3] = struct{ Amount int }{Amount: amount}
+	}
+	s.withdrawCallTotal++
+	if s.WithdrawFailAfter > 0 && s.withdrawCallTotal > s.WithdrawFailAfter {
+		return 0, s.WithdrawFailError
+	}
+	if s.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	return (s.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 3 calls are kept, oldest first.
+func (s *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	if len(s.withdrawCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := s.withdrawCallTotal % len(s.withdrawCalls)
+	calls := make([]struct{ Amount int }, 0, len(s.withdrawCalls))
+	calls = append(calls, s.withdrawCalls[start:]...)
+	return append(calls, s.withdrawCalls[:start]...)
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+// It includes the calls that are no longer kept.
+func (s *WithdrawableAccount) WithdrawCallCount() int {
+	return s.withdrawCallTotal
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (s *WithdrawableAccount) WithdrawCalled() bool {
+	return s.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (s *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := s.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *WithdrawableAccount) Reset() {
+	s.balanceCalls = nil
+	s.balanceCallTotal = 0
+	s.closeCalls = nil
+	s.closeCallTotal = 0
+	s.setNicknameCalls = nil
+	s.setNicknameCallTotal = 0
+	s.summarizeCalls = nil
+	s.summarizeCallTotal = 0
+	s.withdrawCalls = nil
+	s.withdrawCallTotal = 0
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
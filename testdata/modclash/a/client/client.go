@@ -0,0 +1,5 @@
+package client
+
+type Client interface {
+	Get(key string) (string, error)
+}
@@ -0,0 +1,5 @@
+package client
+
+type Client interface {
+	Send(msg []byte) error
+}
@@ -0,0 +1,3 @@
+module example.com/modclash
+
+go 1.18
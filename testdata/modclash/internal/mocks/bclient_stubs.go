@@ -0,0 +1,66 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"example.com/modclash/b/client"
+)
+
+// BclientClient is a stubbed implementation of client.Client.
+type BclientClient struct {
+	// SendStub defines the implementation for Send.
+	SendStub  func(msg []byte) error
+	sendCalls []struct{ Msg []byte }
+}
+
+// NewBclientClient returns a new BclientClient without any stubs set.
+func NewBclientClient() *BclientClient {
+	return &BclientClient{}
+}
+
+// Send delegates its behavior to the field SendStub.
+func (s *BclientClient) Send(msg []byte) error {
+	if s.SendStub == nil {
+		panic("BclientClient.Send: nil method stub")
+	}
+	s.sendCalls = append(s.sendCalls, struct{ Msg []byte }{Msg: msg})
+	return (s.SendStub)(msg)
+}
+
+// SendCalls returns a slice of calls made to Send. Each element
+// of the slice represents the parameters that were provided.
+func (s *BclientClient) SendCalls() []struct{ Msg []byte } {
+	return s.sendCalls
+}
+
+// SendCallCount returns the number of calls made to Send.
+func (s *BclientClient) SendCallCount() int {
+	return len(s.sendCalls)
+}
+
+// SendCalled reports whether Send has been called.
+func (s *BclientClient) SendCalled() bool {
+	return s.SendCallCount() > 0
+}
+
+// SendLastCall returns the parameters of the most recent call to Send,
+// and whether there has been one.
+func (s *BclientClient) SendLastCall() (struct{ Msg []byte }, bool) {
+	calls := s.SendCalls()
+	if len(calls) == 0 {
+		return struct{ Msg []byte }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *BclientClient) Reset() {
+	s.sendCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ client.Client = (*BclientClient)(nil)
@@ -0,0 +1,10 @@
+package billing
+
+type Client interface {
+	Charge(account string, cents int) error
+}
+
+// ledger is unexported, so it isn't stubbed.
+type ledger interface {
+	Post(entry string)
+}
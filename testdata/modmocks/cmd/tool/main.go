@@ -0,0 +1,8 @@
+package main
+
+// Runner can't be stubbed, since a main package can't be imported.
+type Runner interface {
+	Run() error
+}
+
+func main() {}
@@ -0,0 +1,3 @@
+module example.com/modmocks
+
+go 1.18
@@ -0,0 +1,81 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"example.com/modmocks/billing"
+)
+
+// BillingClient is a stubbed implementation of billing.Client.
+type BillingClient struct {
+	// ChargeStub defines the implementation for Charge.
+	ChargeStub  func(account string, cents int) error
+	chargeCalls []struct {
+		Account string
+		Cents   int
+	}
+}
+
+// NewBillingClient returns a new BillingClient without any stubs set.
+func NewBillingClient() *BillingClient {
+	return &BillingClient{}
+}
+
+// Charge delegates its behavior to the field ChargeStub.
+func (s *BillingClient) Charge(account string, cents int) error {
+	if s.ChargeStub == nil {
+		panic("BillingClient.Charge: nil method stub")
+	}
+	s.chargeCalls = append(s.chargeCalls, struct {
+		Account string
+		Cents   int
+	}{Account: account, Cents: cents})
+	return (s.ChargeStub)(account, cents)
+}
+
+// ChargeCalls returns a slice of calls made to Charge. Each element
+// of the slice represents the parameters that were provided.
+func (s *BillingClient) ChargeCalls() []struct {
+	Account string
+	Cents   int
+} {
+	return s.chargeCalls
+}
+
+// ChargeCallCount returns the number of calls made to Charge.
+func (s *BillingClient) ChargeCallCount() int {
+	return len(s.chargeCalls)
+}
+
+// ChargeCalled reports whether Charge has been called.
+func (s *BillingClient) ChargeCalled() bool {
+	return s.ChargeCallCount() > 0
+}
+
+// ChargeLastCall returns the parameters of the most recent call to Charge,
+// and whether there has been one.
+func (s *BillingClient) ChargeLastCall() (struct {
+	Account string
+	Cents   int
+}, bool) {
+	calls := s.ChargeCalls()
+	if len(calls) == 0 {
+		return struct {
+			Account string
+			Cents   int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *BillingClient) Reset() {
+	s.chargeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ billing.Client = (*BillingClient)(nil)
@@ -0,0 +1,67 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"example.com/modmocks/shipping"
+	"time"
+)
+
+// ShippingClient is a stubbed implementation of shipping.Client.
+type ShippingClient struct {
+	// ShipStub defines the implementation for Ship.
+	ShipStub  func(order string) (time.Time, error)
+	shipCalls []struct{ Order string }
+}
+
+// NewShippingClient returns a new ShippingClient without any stubs set.
+func NewShippingClient() *ShippingClient {
+	return &ShippingClient{}
+}
+
+// Ship delegates its behavior to the field ShipStub.
+func (s *ShippingClient) Ship(order string) (time.Time, error) {
+	if s.ShipStub == nil {
+		panic("ShippingClient.Ship: nil method stub")
+	}
+	s.shipCalls = append(s.shipCalls, struct{ Order string }{Order: order})
+	return (s.ShipStub)(order)
+}
+
+// ShipCalls returns a slice of calls made to Ship. Each element
+// of the slice represents the parameters that were provided.
+func (s *ShippingClient) ShipCalls() []struct{ Order string } {
+	return s.shipCalls
+}
+
+// ShipCallCount returns the number of calls made to Ship.
+func (s *ShippingClient) ShipCallCount() int {
+	return len(s.shipCalls)
+}
+
+// ShipCalled reports whether Ship has been called.
+func (s *ShippingClient) ShipCalled() bool {
+	return s.ShipCallCount() > 0
+}
+
+// ShipLastCall returns the parameters of the most recent call to Ship,
+// and whether there has been one.
+func (s *ShippingClient) ShipLastCall() (struct{ Order string }, bool) {
+	calls := s.ShipCalls()
+	if len(calls) == 0 {
+		return struct{ Order string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *ShippingClient) Reset() {
+	s.shipCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ shipping.Client = (*ShippingClient)(nil)
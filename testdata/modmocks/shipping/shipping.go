@@ -0,0 +1,7 @@
+package shipping
+
+import "time"
+
+type Client interface {
+	Ship(order string) (eta time.Time, err error)
+}
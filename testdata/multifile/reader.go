@@ -0,0 +1,7 @@
+package multifile
+
+import "io"
+
+type Reader interface {
+	Open(key string) (io.ReadCloser, error)
+}
@@ -0,0 +1,9 @@
+package multifile
+
+// Store embeds interfaces declared in other files, whose methods refer to
+// packages that this file doesn't import.
+type Store interface {
+	Reader
+	Writer
+	Name() string
+}
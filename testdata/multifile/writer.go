@@ -0,0 +1,7 @@
+package multifile
+
+import "time"
+
+type Writer interface {
+	Put(key string, data []byte, expires time.Time) error
+}
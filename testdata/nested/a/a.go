@@ -0,0 +1,7 @@
+package a
+
+// Service has the same name as the one in package c, but its stub is written
+// to its own output directory, so it keeps its name.
+type Service interface {
+	Start() error
+}
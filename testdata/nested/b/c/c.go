@@ -0,0 +1,5 @@
+package c
+
+type Service interface {
+	Stop()
+}
@@ -0,0 +1,8 @@
+package main
+
+// Runner isn't stubbed, since a main package can't be imported.
+type Runner interface {
+	Run()
+}
+
+func main() {}
@@ -0,0 +1,66 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package a
+
+import (
+	"github.com/dradtke/stubber/testdata/nested/a"
+)
+
+// Service is a stubbed implementation of a.Service.
+type Service struct {
+	// StartStub defines the implementation for Start.
+	StartStub  func() error
+	startCalls []struct{}
+}
+
+// NewService returns a new Service without any stubs set.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Start delegates its behavior to the field StartStub.
+func (s *Service) Start() error {
+	if s.StartStub == nil {
+		panic("Service.Start: nil method stub")
+	}
+	s.startCalls = append(s.startCalls, struct{}{})
+	return (s.StartStub)()
+}
+
+// StartCalls returns a slice of calls made to Start. Each element
+// of the slice represents the parameters that were provided.
+func (s *Service) StartCalls() []struct{} {
+	return s.startCalls
+}
+
+// StartCallCount returns the number of calls made to Start.
+func (s *Service) StartCallCount() int {
+	return len(s.startCalls)
+}
+
+// StartCalled reports whether Start has been called.
+func (s *Service) StartCalled() bool {
+	return s.StartCallCount() > 0
+}
+
+// StartLastCall returns the parameters of the most recent call to Start,
+// and whether there has been one.
+func (s *Service) StartLastCall() (struct{}, bool) {
+	calls := s.StartCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Service) Reset() {
+	s.startCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ a.Service = (*Service)(nil)
@@ -0,0 +1,66 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package c
+
+import (
+	"github.com/dradtke/stubber/testdata/nested/b/c"
+)
+
+// Service is a stubbed implementation of c.Service.
+type Service struct {
+	// StopStub defines the implementation for Stop.
+	StopStub  func()
+	stopCalls []struct{}
+}
+
+// NewService returns a new Service without any stubs set.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Stop delegates its behavior to the field StopStub.
+func (s *Service) Stop() {
+	if s.StopStub == nil {
+		panic("Service.Stop: nil method stub")
+	}
+	s.stopCalls = append(s.stopCalls, struct{}{})
+	(s.StopStub)()
+}
+
+// StopCalls returns a slice of calls made to Stop. Each element
+// of the slice represents the parameters that were provided.
+func (s *Service) StopCalls() []struct{} {
+	return s.stopCalls
+}
+
+// StopCallCount returns the number of calls made to Stop.
+func (s *Service) StopCallCount() int {
+	return len(s.stopCalls)
+}
+
+// StopCalled reports whether Stop has been called.
+func (s *Service) StopCalled() bool {
+	return s.StopCallCount() > 0
+}
+
+// StopLastCall returns the parameters of the most recent call to Stop,
+// and whether there has been one.
+func (s *Service) StopLastCall() (struct{}, bool) {
+	calls := s.StopCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Service) Reset() {
+	s.stopCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ c.Service = (*Service)(nil)
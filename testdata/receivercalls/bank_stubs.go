@@ -0,0 +1,784 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package receivercalls
+
+import (
+	"fmt"
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub      func() int
+	balanceCalls     []struct{}
+	balanceCallTotal int
+	// BalanceReturns holds results returned, in order, by calls to Balance
+	// while BalanceStub is nil. Each one is removed once it's returned.
+	BalanceReturns []struct{ R0 int }
+	// CloseStub defines the implementation for Close.
+	CloseStub      func() error
+	closeCalls     []struct{}
+	closeCallTotal int
+	// CloseReturns holds results returned, in order, by calls to Close
+	// while CloseStub is nil. Each one is removed once it's returned.
+	CloseReturns []struct{ R0 error }
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub      func(s string)
+	setNicknameCalls     []struct{ S string }
+	setNicknameCallTotal int
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub      func(w io.Writer)
+	summarizeCalls     []struct{ W io.Writer }
+	summarizeCallTotal int
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (calls *Account) Balance() int {
+	if calls.balanceCalls == nil {
+		calls.balanceCalls = make([]struct{}, 0, 2)
+	}
+	if len(calls.balanceCalls) < 2 {
+		calls.balanceCalls = append(calls.balanceCalls, struct{}{})
+	} else {
+		calls.balanceCalls[calls.balanceCallTotal%2] = struct{}{}
+	}
+	calls.balanceCallTotal++
+	next := calls.nextBalanceReturns()
+	if calls.BalanceStub == nil && next == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (calls.BalanceStub)()
+}
+
+// nextBalanceReturns removes and returns the first of BalanceReturns, or
+// nil if BalanceStub is set or there are none left.
+func (calls *Account) nextBalanceReturns() *struct{ R0 int } {
+	if calls.BalanceStub != nil {
+		return nil
+	}
+	if len(calls.BalanceReturns) == 0 {
+		return nil
+	}
+	next := calls.BalanceReturns[0]
+	calls.BalanceReturns = calls.BalanceReturns[1:]
+	return &next
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *Account) BalanceCalls() []struct{} {
+	if len(calls.balanceCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.balanceCallTotal % len(calls.balanceCalls)
+	calls_ := make([]struct{}, 0, len(calls.balanceCalls))
+	calls_ = append(calls_, calls.balanceCalls[start:]...)
+	return append(calls_, calls.balanceCalls[:start]...)
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+// It includes the calls that are no longer kept.
+func (calls *Account) BalanceCallCount() int {
+	return calls.balanceCallTotal
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (calls *Account) BalanceCalled() bool {
+	return calls.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (calls *Account) BalanceLastCall() (struct{}, bool) {
+	calls_ := calls.BalanceCalls()
+	if len(calls_) == 0 {
+		return struct{}{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (calls *Account) Close() error {
+	if calls.closeCalls == nil {
+		calls.closeCalls = make([]struct{}, 0, 2)
+	}
+	if len(calls.closeCalls) < 2 {
+		calls.closeCalls = append(calls.closeCalls, struct{}{})
+	} else {
+		calls.closeCalls[calls.closeCallTotal%2] = struct{}{}
+	}
+	calls.closeCallTotal++
+	next := calls.nextCloseReturns()
+	if calls.CloseStub == nil && next == nil {
+		panic("Account.Close: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (calls.CloseStub)()
+}
+
+// nextCloseReturns removes and returns the first of CloseReturns, or
+// nil if CloseStub is set or there are none left.
+func (calls *Account) nextCloseReturns() *struct{ R0 error } {
+	if calls.CloseStub != nil {
+		return nil
+	}
+	if len(calls.CloseReturns) == 0 {
+		return nil
+	}
+	next := calls.CloseReturns[0]
+	calls.CloseReturns = calls.CloseReturns[1:]
+	return &next
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *Account) CloseCalls() []struct{} {
+	if len(calls.closeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.closeCallTotal % len(calls.closeCalls)
+	calls_ := make([]struct{}, 0, len(calls.closeCalls))
+	calls_ = append(calls_, calls.closeCalls[start:]...)
+	return append(calls_, calls.closeCalls[:start]...)
+}
+
+// CloseCallCount returns the number of calls made to Close.
+// It includes the calls that are no longer kept.
+func (calls *Account) CloseCallCount() int {
+	return calls.closeCallTotal
+}
+
+// CloseCalled reports whether Close has been called.
+func (calls *Account) CloseCalled() bool {
+	return calls.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (calls *Account) CloseLastCall() (struct{}, bool) {
+	calls_ := calls.CloseCalls()
+	if len(calls_) == 0 {
+		return struct{}{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (calls *Account) SetNickname(s string) {
+	if calls.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	if calls.setNicknameCalls == nil {
+		calls.setNicknameCalls = make([]struct{ S string }, 0, 2)
+	}
+	if len(calls.setNicknameCalls) < 2 {
+		calls.setNicknameCalls = append(calls.setNicknameCalls, struct{ S string }{S: s})
+	} else {
+		calls.setNicknameCalls[calls.setNicknameCallTotal%2] = struct{ S string }{S: s}
+	}
+	calls.setNicknameCallTotal++
+	(calls.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *Account) SetNicknameCalls() []struct{ S string } {
+	if len(calls.setNicknameCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.setNicknameCallTotal % len(calls.setNicknameCalls)
+	calls_ := make([]struct{ S string }, 0, len(calls.setNicknameCalls))
+	calls_ = append(calls_, calls.setNicknameCalls[start:]...)
+	return append(calls_, calls.setNicknameCalls[:start]...)
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+// It includes the calls that are no longer kept.
+func (calls *Account) SetNicknameCallCount() int {
+	return calls.setNicknameCallTotal
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (calls *Account) SetNicknameCalled() bool {
+	return calls.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (calls *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls_ := calls.SetNicknameCalls()
+	if len(calls_) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SetNicknameCallCountByArg returns the number of calls made to SetNickname for each
+// distinct argument.
+func (calls *Account) SetNicknameCallCountByArg() map[string]int {
+	counts := make(map[string]int)
+	for _, call := range calls.SetNicknameCalls() {
+		counts[call.S]++
+	}
+	return counts
+}
+
+// SetNicknameFirstMatching returns the first recorded call to SetNickname for which
+// pred returns true, and false if there isn't one.
+func (calls *Account) SetNicknameFirstMatching(pred func(struct{ S string }) bool) (struct{ S string }, bool) {
+	for _, call := range calls.SetNicknameCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ S string }{}, false
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (calls *Account) Summarize(w io.Writer) {
+	if calls.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	if calls.summarizeCalls == nil {
+		calls.summarizeCalls = make([]struct{ W io.Writer }, 0, 2)
+	}
+	if len(calls.summarizeCalls) < 2 {
+		calls.summarizeCalls = append(calls.summarizeCalls, struct{ W io.Writer }{W: w})
+	} else {
+		calls.summarizeCalls[calls.summarizeCallTotal%2] = struct{ W io.Writer }{W: w}
+	}
+	calls.summarizeCallTotal++
+	(calls.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *Account) SummarizeCalls() []struct{ W io.Writer } {
+	if len(calls.summarizeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.summarizeCallTotal % len(calls.summarizeCalls)
+	calls_ := make([]struct{ W io.Writer }, 0, len(calls.summarizeCalls))
+	calls_ = append(calls_, calls.summarizeCalls[start:]...)
+	return append(calls_, calls.summarizeCalls[:start]...)
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+// It includes the calls that are no longer kept.
+func (calls *Account) SummarizeCallCount() int {
+	return calls.summarizeCallTotal
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (calls *Account) SummarizeCalled() bool {
+	return calls.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (calls *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls_ := calls.SummarizeCalls()
+	if len(calls_) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SummarizeCallCountByArg returns the number of calls made to Summarize for each
+// distinct set of arguments, formatted as a string.
+func (calls *Account) SummarizeCallCountByArg() map[string]int {
+	counts := make(map[string]int)
+	for _, call := range calls.SummarizeCalls() {
+		counts[fmt.Sprintf("%+v", call)]++
+	}
+	return counts
+}
+
+// SummarizeFirstMatching returns the first recorded call to Summarize for which
+// pred returns true, and false if there isn't one.
+func (calls *Account) SummarizeFirstMatching(pred func(struct{ W io.Writer }) bool) (struct{ W io.Writer }, bool) {
+	for _, call := range calls.SummarizeCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ W io.Writer }{}, false
+}
+
+// Reset clears the calls recorded by calls, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (calls *Account) Reset() {
+	calls.balanceCalls = nil
+	calls.balanceCallTotal = 0
+	calls.closeCalls = nil
+	calls.closeCallTotal = 0
+	calls.setNicknameCalls = nil
+	calls.setNicknameCallTotal = 0
+	calls.summarizeCalls = nil
+	calls.summarizeCallTotal = 0
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub      func() int
+	balanceCalls     []struct{}
+	balanceCallTotal int
+	// BalanceReturns holds results returned, in order, by calls to Balance
+	// while BalanceStub is nil. Each one is removed once it's returned.
+	BalanceReturns []struct{ R0 int }
+	// CloseStub defines the implementation for Close.
+	CloseStub      func() error
+	closeCalls     []struct{}
+	closeCallTotal int
+	// CloseReturns holds results returned, in order, by calls to Close
+	// while CloseStub is nil. Each one is removed once it's returned.
+	CloseReturns []struct{ R0 error }
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub      func(s string)
+	setNicknameCalls     []struct{ S string }
+	setNicknameCallTotal int
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub      func(w io.Writer)
+	summarizeCalls     []struct{ W io.Writer }
+	summarizeCallTotal int
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub      func(amount int) (int, error)
+	withdrawCalls     []struct{ Amount int }
+	withdrawCallTotal int
+	// WithdrawReturns holds results returned, in order, by calls to Withdraw
+	// while WithdrawStub is nil. Each one is removed once it's returned.
+	WithdrawReturns []struct {
+		R0 int
+		R1 error
+	}
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (calls *WithdrawableAccount) Balance() int {
+	if calls.balanceCalls == nil {
+		calls.balanceCalls = make([]struct{}, 0, 2)
+	}
+	if len(calls.balanceCalls) < 2 {
+		calls.balanceCalls = append(calls.balanceCalls, struct{}{})
+	} else {
+		calls.balanceCalls[calls.balanceCallTotal%2] = struct{}{}
+	}
+	calls.balanceCallTotal++
+	next := calls.nextBalanceReturns()
+	if calls.BalanceStub == nil && next == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (calls.BalanceStub)()
+}
+
+// nextBalanceReturns removes and returns the first of BalanceReturns, or
+// nil if BalanceStub is set or there are none left.
+func (calls *WithdrawableAccount) nextBalanceReturns() *struct{ R0 int } {
+	if calls.BalanceStub != nil {
+		return nil
+	}
+	if len(calls.BalanceReturns) == 0 {
+		return nil
+	}
+	next := calls.BalanceReturns[0]
+	calls.BalanceReturns = calls.BalanceReturns[1:]
+	return &next
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *WithdrawableAccount) BalanceCalls() []struct{} {
+	if len(calls.balanceCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.balanceCallTotal % len(calls.balanceCalls)
+	calls_ := make([]struct{}, 0, len(calls.balanceCalls))
+	calls_ = append(calls_, calls.balanceCalls[start:]...)
+	return append(calls_, calls.balanceCalls[:start]...)
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+// It includes the calls that are no longer kept.
+func (calls *WithdrawableAccount) BalanceCallCount() int {
+	return calls.balanceCallTotal
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (calls *WithdrawableAccount) BalanceCalled() bool {
+	return calls.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (calls *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls_ := calls.BalanceCalls()
+	if len(calls_) == 0 {
+		return struct{}{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (calls *WithdrawableAccount) Close() error {
+	if calls.closeCalls == nil {
+		calls.closeCalls = make([]struct{}, 0, 2)
+	}
+	if len(calls.closeCalls) < 2 {
+		calls.closeCalls = append(calls.closeCalls, struct{}{})
+	} else {
+		calls.closeCalls[calls.closeCallTotal%2] = struct{}{}
+	}
+	calls.closeCallTotal++
+	next := calls.nextCloseReturns()
+	if calls.CloseStub == nil && next == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	if next != nil {
+		return next.R0
+	}
+	return (calls.CloseStub)()
+}
+
+// nextCloseReturns removes and returns the first of CloseReturns, or
+// nil if CloseStub is set or there are none left.
+func (calls *WithdrawableAccount) nextCloseReturns() *struct{ R0 error } {
+	if calls.CloseStub != nil {
+		return nil
+	}
+	if len(calls.CloseReturns) == 0 {
+		return nil
+	}
+	next := calls.CloseReturns[0]
+	calls.CloseReturns = calls.CloseReturns[1:]
+	return &next
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *WithdrawableAccount) CloseCalls() []struct{} {
+	if len(calls.closeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.closeCallTotal % len(calls.closeCalls)
+	calls_ := make([]struct{}, 0, len(calls.closeCalls))
+	calls_ = append(calls_, calls.closeCalls[start:]...)
+	return append(calls_, calls.closeCalls[:start]...)
+}
+
+// CloseCallCount returns the number of calls made to Close.
+// It includes the calls that are no longer kept.
+func (calls *WithdrawableAccount) CloseCallCount() int {
+	return calls.closeCallTotal
+}
+
+// CloseCalled reports whether Close has been called.
+func (calls *WithdrawableAccount) CloseCalled() bool {
+	return calls.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (calls *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls_ := calls.CloseCalls()
+	if len(calls_) == 0 {
+		return struct{}{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (calls *WithdrawableAccount) SetNickname(s string) {
+	if calls.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	if calls.setNicknameCalls == nil {
+		calls.setNicknameCalls = make([]struct{ S string }, 0, 2)
+	}
+	if len(calls.setNicknameCalls) < 2 {
+		calls.setNicknameCalls = append(calls.setNicknameCalls, struct{ S string }{S: s})
+	} else {
+		calls.setNicknameCalls[calls.setNicknameCallTotal%2] = struct{ S string }{S: s}
+	}
+	calls.setNicknameCallTotal++
+	(calls.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	if len(calls.setNicknameCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.setNicknameCallTotal % len(calls.setNicknameCalls)
+	calls_ := make([]struct{ S string }, 0, len(calls.setNicknameCalls))
+	calls_ = append(calls_, calls.setNicknameCalls[start:]...)
+	return append(calls_, calls.setNicknameCalls[:start]...)
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+// It includes the calls that are no longer kept.
+func (calls *WithdrawableAccount) SetNicknameCallCount() int {
+	return calls.setNicknameCallTotal
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (calls *WithdrawableAccount) SetNicknameCalled() bool {
+	return calls.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (calls *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls_ := calls.SetNicknameCalls()
+	if len(calls_) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SetNicknameCallCountByArg returns the number of calls made to SetNickname for each
+// distinct argument.
+func (calls *WithdrawableAccount) SetNicknameCallCountByArg() map[string]int {
+	counts := make(map[string]int)
+	for _, call := range calls.SetNicknameCalls() {
+		counts[call.S]++
+	}
+	return counts
+}
+
+// SetNicknameFirstMatching returns the first recorded call to SetNickname for which
+// pred returns true, and false if there isn't one.
+func (calls *WithdrawableAccount) SetNicknameFirstMatching(pred func(struct{ S string }) bool) (struct{ S string }, bool) {
+	for _, call := range calls.SetNicknameCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ S string }{}, false
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (calls *WithdrawableAccount) Summarize(w io.Writer) {
+	if calls.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	if calls.summarizeCalls == nil {
+		calls.summarizeCalls = make([]struct{ W io.Writer }, 0, 2)
+	}
+	if len(calls.summarizeCalls) < 2 {
+		calls.summarizeCalls = append(calls.summarizeCalls, struct{ W io.Writer }{W: w})
+	} else {
+		calls.summarizeCalls[calls.summarizeCallTotal%2] = struct{ W io.Writer }{W: w}
+	}
+	calls.summarizeCallTotal++
+	(calls.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	if len(calls.summarizeCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.summarizeCallTotal % len(calls.summarizeCalls)
+	calls_ := make([]struct{ W io.Writer }, 0, len(calls.summarizeCalls))
+	calls_ = append(calls_, calls.summarizeCalls[start:]...)
+	return append(calls_, calls.summarizeCalls[:start]...)
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+// It includes the calls that are no longer kept.
+func (calls *WithdrawableAccount) SummarizeCallCount() int {
+	return calls.summarizeCallTotal
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (calls *WithdrawableAccount) SummarizeCalled() bool {
+	return calls.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (calls *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls_ := calls.SummarizeCalls()
+	if len(calls_) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// SummarizeCallCountByArg returns the number of calls made to Summarize for each
+// distinct set of arguments, formatted as a string.
+func (calls *WithdrawableAccount) SummarizeCallCountByArg() map[string]int {
+	counts := make(map[string]int)
+	for _, call := range calls.SummarizeCalls() {
+		counts[fmt.Sprintf("%+v", call)]++
+	}
+	return counts
+}
+
+// SummarizeFirstMatching returns the first recorded call to Summarize for which
+// pred returns true, and false if there isn't one.
+func (calls *WithdrawableAccount) SummarizeFirstMatching(pred func(struct{ W io.Writer }) bool) (struct{ W io.Writer }, bool) {
+	for _, call := range calls.SummarizeCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ W io.Writer }{}, false
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (calls *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if calls.withdrawCalls == nil {
+		calls.withdrawCalls = make([]struct{ Amount int }, 0, 2)
+	}
+	if len(calls.withdrawCalls) < 2 {
+		calls.withdrawCalls = append(calls.withdrawCalls, struct{ Amount int }{Amount: amount})
+	} else {
+		calls.withdrawCalls[calls.withdrawCallTotal%2] = struct{ Amount int }{Amount: amount}
+	}
+	calls.withdrawCallTotal++
+	next := calls.nextWithdrawReturns()
+	if calls.WithdrawStub == nil && next == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	if next != nil {
+		return next.R0, next.R1
+	}
+	return (calls.WithdrawStub)(amount)
+}
+
+// nextWithdrawReturns removes and returns the first of WithdrawReturns, or
+// nil if WithdrawStub is set or there are none left.
+func (calls *WithdrawableAccount) nextWithdrawReturns() *struct {
+	R0 int
+	R1 error
+} {
+	if calls.WithdrawStub != nil {
+		return nil
+	}
+	if len(calls.WithdrawReturns) == 0 {
+		return nil
+	}
+	next := calls.WithdrawReturns[0]
+	calls.WithdrawReturns = calls.WithdrawReturns[1:]
+	return &next
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+// Only the most recent 2 calls are kept, oldest first.
+func (calls *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	if len(calls.withdrawCalls) == 0 {
+		return nil
+	}
+	// Once the buffer is full, the oldest call follows the most recent one.
+	start := calls.withdrawCallTotal % len(calls.withdrawCalls)
+	calls_ := make([]struct{ Amount int }, 0, len(calls.withdrawCalls))
+	calls_ = append(calls_, calls.withdrawCalls[start:]...)
+	return append(calls_, calls.withdrawCalls[:start]...)
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+// It includes the calls that are no longer kept.
+func (calls *WithdrawableAccount) WithdrawCallCount() int {
+	return calls.withdrawCallTotal
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (calls *WithdrawableAccount) WithdrawCalled() bool {
+	return calls.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (calls *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls_ := calls.WithdrawCalls()
+	if len(calls_) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls_[len(calls_)-1], true
+}
+
+// WithdrawCallCountByArg returns the number of calls made to Withdraw for each
+// distinct argument.
+func (calls *WithdrawableAccount) WithdrawCallCountByArg() map[int]int {
+	counts := make(map[int]int)
+	for _, call := range calls.WithdrawCalls() {
+		counts[call.Amount]++
+	}
+	return counts
+}
+
+// WithdrawFirstMatching returns the first recorded call to Withdraw for which
+// pred returns true, and false if there isn't one.
+func (calls *WithdrawableAccount) WithdrawFirstMatching(pred func(struct{ Amount int }) bool) (struct{ Amount int }, bool) {
+	for _, call := range calls.WithdrawCalls() {
+		if pred(call) {
+			return call, true
+		}
+	}
+	return struct{ Amount int }{}, false
+}
+
+// Reset clears the calls recorded by calls, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (calls *WithdrawableAccount) Reset() {
+	calls.balanceCalls = nil
+	calls.balanceCallTotal = 0
+	calls.closeCalls = nil
+	calls.closeCallTotal = 0
+	calls.setNicknameCalls = nil
+	calls.setNicknameCallTotal = 0
+	calls.summarizeCalls = nil
+	calls.summarizeCallTotal = 0
+	calls.withdrawCalls = nil
+	calls.withdrawCallTotal = 0
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
@@ -0,0 +1,669 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package receiverclash
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+	"testing"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	callLog []string
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (tb *Account) Balance() int {
+	if tb.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	tb.balanceCalls = append(tb.balanceCalls, struct{}{})
+	tb.callLog = append(tb.callLog, "Balance")
+	return (tb.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (tb *Account) BalanceCalls() []struct{} {
+	return tb.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (tb *Account) BalanceCallCount() int {
+	return len(tb.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (tb *Account) BalanceCalled() bool {
+	return tb.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (tb *Account) BalanceLastCall() (struct{}, bool) {
+	calls := tb.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (tb *Account) Close() error {
+	if tb.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	tb.closeCalls = append(tb.closeCalls, struct{}{})
+	tb.callLog = append(tb.callLog, "Close")
+	return (tb.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (tb *Account) CloseCalls() []struct{} {
+	return tb.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (tb *Account) CloseCallCount() int {
+	return len(tb.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (tb *Account) CloseCalled() bool {
+	return tb.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (tb *Account) CloseLastCall() (struct{}, bool) {
+	calls := tb.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (tb *Account) SetNickname(s string) {
+	if tb.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	tb.setNicknameCalls = append(tb.setNicknameCalls, struct{ S string }{S: s})
+	tb.callLog = append(tb.callLog, "SetNickname")
+	(tb.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (tb *Account) SetNicknameCalls() []struct{ S string } {
+	return tb.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (tb *Account) SetNicknameCallCount() int {
+	return len(tb.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (tb *Account) SetNicknameCalled() bool {
+	return tb.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (tb *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := tb.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (tb *Account) Summarize(w io.Writer) {
+	if tb.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	tb.summarizeCalls = append(tb.summarizeCalls, struct{ W io.Writer }{W: w})
+	tb.callLog = append(tb.callLog, "Summarize")
+	(tb.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (tb *Account) SummarizeCalls() []struct{ W io.Writer } {
+	return tb.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (tb *Account) SummarizeCallCount() int {
+	return len(tb.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (tb *Account) SummarizeCalled() bool {
+	return tb.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (tb *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := tb.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// AssertAllStubsUsed registers a cleanup with tb that reports an error for
+// each stub of tb that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+func (tb *Account) AssertAllStubsUsed(tb_ testing.TB) {
+	tb_.Cleanup(func() {
+		if tb.BalanceStub != nil && len(tb.balanceCalls) == 0 {
+			tb_.Errorf("Account: BalanceStub was set, but Balance was never called")
+		}
+		if tb.CloseStub != nil && len(tb.closeCalls) == 0 {
+			tb_.Errorf("Account: CloseStub was set, but Close was never called")
+		}
+		if tb.SetNicknameStub != nil && len(tb.setNicknameCalls) == 0 {
+			tb_.Errorf("Account: SetNicknameStub was set, but SetNickname was never called")
+		}
+		if tb.SummarizeStub != nil && len(tb.summarizeCalls) == 0 {
+			tb_.Errorf("Account: SummarizeStub was set, but Summarize was never called")
+		}
+	})
+}
+
+// Verify reports an error to tb for each stub of tb that was set but
+// hasn't been called yet.
+func (tb *Account) Verify(tb_ testing.TB) {
+	tb_.Helper()
+	if tb.BalanceStub != nil && tb.BalanceCallCount() == 0 {
+		tb_.Errorf("Account: BalanceStub was set, but Balance was never called")
+	}
+	if tb.CloseStub != nil && tb.CloseCallCount() == 0 {
+		tb_.Errorf("Account: CloseStub was set, but Close was never called")
+	}
+	if tb.SetNicknameStub != nil && tb.SetNicknameCallCount() == 0 {
+		tb_.Errorf("Account: SetNicknameStub was set, but SetNickname was never called")
+	}
+	if tb.SummarizeStub != nil && tb.SummarizeCallCount() == 0 {
+		tb_.Errorf("Account: SummarizeStub was set, but Summarize was never called")
+	}
+}
+
+// MarshalText encodes the calls made to tb as text, with a line for each
+// call holding the method name and its parameters as JSON. Calls are grouped
+// by method so that the result is stable enough for snapshot tests.
+func (tb *Account) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, call := range tb.BalanceCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Balance ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.CloseCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Close ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.SetNicknameCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("SetNickname ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.SummarizeCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Summarize ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// CallLog returns the names of the methods called on tb, in the order in
+// which they were called.
+func (tb *Account) CallLog() []string {
+	return tb.callLog
+}
+
+// AssertCallOrder reports an error to tb unless the methods called on tb
+// were exactly methods, in that order.
+func (tb *Account) AssertCallOrder(tb_ testing.TB, methods ...string) {
+	tb_.Helper()
+	calls := tb.CallLog()
+	if len(calls) != len(methods) {
+		tb_.Errorf("Account: got calls %q, want %q", calls, methods)
+		return
+	}
+	for i := range methods {
+		if calls[i] != methods[i] {
+			tb_.Errorf("Account: got calls %q, want %q", calls, methods)
+			return
+		}
+	}
+}
+
+// AssertCallSubsequence reports an error to tb unless methods were called on
+// tb in that order, possibly interleaved with other calls.
+func (tb *Account) AssertCallSubsequence(tb_ testing.TB, methods ...string) {
+	tb_.Helper()
+	calls := tb.CallLog()
+	i := 0
+	for _, method := range calls {
+		if i < len(methods) && method == methods[i] {
+			i++
+		}
+	}
+	if i < len(methods) {
+		tb_.Errorf("Account: got calls %q, want them to include %q in order", calls, methods)
+	}
+}
+
+// Reset clears the calls recorded by tb, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (tb *Account) Reset() {
+	tb.balanceCalls = nil
+	tb.closeCalls = nil
+	tb.setNicknameCalls = nil
+	tb.summarizeCalls = nil
+	tb.callLog = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	callLog []string
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (tb *WithdrawableAccount) Balance() int {
+	if tb.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	tb.balanceCalls = append(tb.balanceCalls, struct{}{})
+	tb.callLog = append(tb.callLog, "Balance")
+	return (tb.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (tb *WithdrawableAccount) BalanceCalls() []struct{} {
+	return tb.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (tb *WithdrawableAccount) BalanceCallCount() int {
+	return len(tb.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (tb *WithdrawableAccount) BalanceCalled() bool {
+	return tb.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (tb *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := tb.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (tb *WithdrawableAccount) Close() error {
+	if tb.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	tb.closeCalls = append(tb.closeCalls, struct{}{})
+	tb.callLog = append(tb.callLog, "Close")
+	return (tb.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (tb *WithdrawableAccount) CloseCalls() []struct{} {
+	return tb.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (tb *WithdrawableAccount) CloseCallCount() int {
+	return len(tb.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (tb *WithdrawableAccount) CloseCalled() bool {
+	return tb.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (tb *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := tb.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (tb *WithdrawableAccount) SetNickname(s string) {
+	if tb.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	tb.setNicknameCalls = append(tb.setNicknameCalls, struct{ S string }{S: s})
+	tb.callLog = append(tb.callLog, "SetNickname")
+	(tb.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (tb *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return tb.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (tb *WithdrawableAccount) SetNicknameCallCount() int {
+	return len(tb.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (tb *WithdrawableAccount) SetNicknameCalled() bool {
+	return tb.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (tb *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := tb.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (tb *WithdrawableAccount) Summarize(w io.Writer) {
+	if tb.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	tb.summarizeCalls = append(tb.summarizeCalls, struct{ W io.Writer }{W: w})
+	tb.callLog = append(tb.callLog, "Summarize")
+	(tb.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (tb *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return tb.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (tb *WithdrawableAccount) SummarizeCallCount() int {
+	return len(tb.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (tb *WithdrawableAccount) SummarizeCalled() bool {
+	return tb.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (tb *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := tb.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (tb *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if tb.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	tb.withdrawCalls = append(tb.withdrawCalls, struct{ Amount int }{Amount: amount})
+	tb.callLog = append(tb.callLog, "Withdraw")
+	return (tb.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (tb *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return tb.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (tb *WithdrawableAccount) WithdrawCallCount() int {
+	return len(tb.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (tb *WithdrawableAccount) WithdrawCalled() bool {
+	return tb.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (tb *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := tb.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// AssertAllStubsUsed registers a cleanup with tb that reports an error for
+// each stub of tb that was set but never called, which usually means that
+// a test doesn't exercise what it's meant to.
+func (tb *WithdrawableAccount) AssertAllStubsUsed(tb_ testing.TB) {
+	tb_.Cleanup(func() {
+		if tb.BalanceStub != nil && len(tb.balanceCalls) == 0 {
+			tb_.Errorf("WithdrawableAccount: BalanceStub was set, but Balance was never called")
+		}
+		if tb.CloseStub != nil && len(tb.closeCalls) == 0 {
+			tb_.Errorf("WithdrawableAccount: CloseStub was set, but Close was never called")
+		}
+		if tb.SetNicknameStub != nil && len(tb.setNicknameCalls) == 0 {
+			tb_.Errorf("WithdrawableAccount: SetNicknameStub was set, but SetNickname was never called")
+		}
+		if tb.SummarizeStub != nil && len(tb.summarizeCalls) == 0 {
+			tb_.Errorf("WithdrawableAccount: SummarizeStub was set, but Summarize was never called")
+		}
+		if tb.WithdrawStub != nil && len(tb.withdrawCalls) == 0 {
+			tb_.Errorf("WithdrawableAccount: WithdrawStub was set, but Withdraw was never called")
+		}
+	})
+}
+
+// Verify reports an error to tb for each stub of tb that was set but
+// hasn't been called yet.
+func (tb *WithdrawableAccount) Verify(tb_ testing.TB) {
+	tb_.Helper()
+	if tb.BalanceStub != nil && tb.BalanceCallCount() == 0 {
+		tb_.Errorf("WithdrawableAccount: BalanceStub was set, but Balance was never called")
+	}
+	if tb.CloseStub != nil && tb.CloseCallCount() == 0 {
+		tb_.Errorf("WithdrawableAccount: CloseStub was set, but Close was never called")
+	}
+	if tb.SetNicknameStub != nil && tb.SetNicknameCallCount() == 0 {
+		tb_.Errorf("WithdrawableAccount: SetNicknameStub was set, but SetNickname was never called")
+	}
+	if tb.SummarizeStub != nil && tb.SummarizeCallCount() == 0 {
+		tb_.Errorf("WithdrawableAccount: SummarizeStub was set, but Summarize was never called")
+	}
+	if tb.WithdrawStub != nil && tb.WithdrawCallCount() == 0 {
+		tb_.Errorf("WithdrawableAccount: WithdrawStub was set, but Withdraw was never called")
+	}
+}
+
+// MarshalText encodes the calls made to tb as text, with a line for each
+// call holding the method name and its parameters as JSON. Calls are grouped
+// by method so that the result is stable enough for snapshot tests.
+func (tb *WithdrawableAccount) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, call := range tb.BalanceCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Balance ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.CloseCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Close ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.SetNicknameCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("SetNickname ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.SummarizeCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Summarize ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	for _, call := range tb.WithdrawCalls() {
+		args, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("Withdraw ")
+		buf.Write(args)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// CallLog returns the names of the methods called on tb, in the order in
+// which they were called.
+func (tb *WithdrawableAccount) CallLog() []string {
+	return tb.callLog
+}
+
+// AssertCallOrder reports an error to tb unless the methods called on tb
+// were exactly methods, in that order.
+func (tb *WithdrawableAccount) AssertCallOrder(tb_ testing.TB, methods ...string) {
+	tb_.Helper()
+	calls := tb.CallLog()
+	if len(calls) != len(methods) {
+		tb_.Errorf("WithdrawableAccount: got calls %q, want %q", calls, methods)
+		return
+	}
+	for i := range methods {
+		if calls[i] != methods[i] {
+			tb_.Errorf("WithdrawableAccount: got calls %q, want %q", calls, methods)
+			return
+		}
+	}
+}
+
+// AssertCallSubsequence reports an error to tb unless methods were called on
+// tb in that order, possibly interleaved with other calls.
+func (tb *WithdrawableAccount) AssertCallSubsequence(tb_ testing.TB, methods ...string) {
+	tb_.Helper()
+	calls := tb.CallLog()
+	i := 0
+	for _, method := range calls {
+		if i < len(methods) && method == methods[i] {
+			i++
+		}
+	}
+	if i < len(methods) {
+		tb_.Errorf("WithdrawableAccount: got calls %q, want them to include %q in order", calls, methods)
+	}
+}
+
+// Reset clears the calls recorded by tb, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (tb *WithdrawableAccount) Reset() {
+	tb.balanceCalls = nil
+	tb.closeCalls = nil
+	tb.setNicknameCalls = nil
+	tb.summarizeCalls = nil
+	tb.withdrawCalls = nil
+	tb.callLog = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
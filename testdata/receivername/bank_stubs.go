@@ -0,0 +1,396 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package receivername
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (stub *Account) Balance() int {
+	if stub.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	stub.balanceCalls = append(stub.balanceCalls, struct{}{})
+	return (stub.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (stub *Account) BalanceCalls() []struct{} {
+	return stub.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (stub *Account) BalanceCallCount() int {
+	return len(stub.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (stub *Account) BalanceCalled() bool {
+	return stub.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (stub *Account) BalanceLastCall() (struct{}, bool) {
+	calls := stub.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (stub *Account) Close() error {
+	if stub.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	stub.closeCalls = append(stub.closeCalls, struct{}{})
+	return (stub.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (stub *Account) CloseCalls() []struct{} {
+	return stub.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (stub *Account) CloseCallCount() int {
+	return len(stub.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (stub *Account) CloseCalled() bool {
+	return stub.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (stub *Account) CloseLastCall() (struct{}, bool) {
+	calls := stub.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (stub *Account) SetNickname(s string) {
+	if stub.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	stub.setNicknameCalls = append(stub.setNicknameCalls, struct{ S string }{S: s})
+	(stub.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (stub *Account) SetNicknameCalls() []struct{ S string } {
+	return stub.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (stub *Account) SetNicknameCallCount() int {
+	return len(stub.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (stub *Account) SetNicknameCalled() bool {
+	return stub.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (stub *Account) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := stub.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (stub *Account) Summarize(w io.Writer) {
+	if stub.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	stub.summarizeCalls = append(stub.summarizeCalls, struct{ W io.Writer }{W: w})
+	(stub.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (stub *Account) SummarizeCalls() []struct{ W io.Writer } {
+	return stub.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (stub *Account) SummarizeCallCount() int {
+	return len(stub.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (stub *Account) SummarizeCalled() bool {
+	return stub.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (stub *Account) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := stub.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by stub, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (stub *Account) Reset() {
+	stub.balanceCalls = nil
+	stub.closeCalls = nil
+	stub.setNicknameCalls = nil
+	stub.summarizeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = (*Account)(nil)
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub  func(amount int) (int, error)
+	withdrawCalls []struct{ Amount int }
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (stub *WithdrawableAccount) Balance() int {
+	if stub.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	stub.balanceCalls = append(stub.balanceCalls, struct{}{})
+	return (stub.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (stub *WithdrawableAccount) BalanceCalls() []struct{} {
+	return stub.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (stub *WithdrawableAccount) BalanceCallCount() int {
+	return len(stub.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (stub *WithdrawableAccount) BalanceCalled() bool {
+	return stub.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (stub *WithdrawableAccount) BalanceLastCall() (struct{}, bool) {
+	calls := stub.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (stub *WithdrawableAccount) Close() error {
+	if stub.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	stub.closeCalls = append(stub.closeCalls, struct{}{})
+	return (stub.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (stub *WithdrawableAccount) CloseCalls() []struct{} {
+	return stub.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (stub *WithdrawableAccount) CloseCallCount() int {
+	return len(stub.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (stub *WithdrawableAccount) CloseCalled() bool {
+	return stub.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (stub *WithdrawableAccount) CloseLastCall() (struct{}, bool) {
+	calls := stub.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (stub *WithdrawableAccount) SetNickname(s string) {
+	if stub.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	stub.setNicknameCalls = append(stub.setNicknameCalls, struct{ S string }{S: s})
+	(stub.SetNicknameStub)(s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (stub *WithdrawableAccount) SetNicknameCalls() []struct{ S string } {
+	return stub.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (stub *WithdrawableAccount) SetNicknameCallCount() int {
+	return len(stub.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (stub *WithdrawableAccount) SetNicknameCalled() bool {
+	return stub.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (stub *WithdrawableAccount) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := stub.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (stub *WithdrawableAccount) Summarize(w io.Writer) {
+	if stub.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	stub.summarizeCalls = append(stub.summarizeCalls, struct{ W io.Writer }{W: w})
+	(stub.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (stub *WithdrawableAccount) SummarizeCalls() []struct{ W io.Writer } {
+	return stub.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (stub *WithdrawableAccount) SummarizeCallCount() int {
+	return len(stub.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (stub *WithdrawableAccount) SummarizeCalled() bool {
+	return stub.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (stub *WithdrawableAccount) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := stub.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (stub *WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if stub.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	stub.withdrawCalls = append(stub.withdrawCalls, struct{ Amount int }{Amount: amount})
+	return (stub.WithdrawStub)(amount)
+}
+
+// WithdrawCalls returns a slice of calls made to Withdraw. Each element
+// of the slice represents the parameters that were provided.
+func (stub *WithdrawableAccount) WithdrawCalls() []struct{ Amount int } {
+	return stub.withdrawCalls
+}
+
+// WithdrawCallCount returns the number of calls made to Withdraw.
+func (stub *WithdrawableAccount) WithdrawCallCount() int {
+	return len(stub.withdrawCalls)
+}
+
+// WithdrawCalled reports whether Withdraw has been called.
+func (stub *WithdrawableAccount) WithdrawCalled() bool {
+	return stub.WithdrawCallCount() > 0
+}
+
+// WithdrawLastCall returns the parameters of the most recent call to Withdraw,
+// and whether there has been one.
+func (stub *WithdrawableAccount) WithdrawLastCall() (struct{ Amount int }, bool) {
+	calls := stub.WithdrawCalls()
+	if len(calls) == 0 {
+		return struct{ Amount int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by stub, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (stub *WithdrawableAccount) Reset() {
+	stub.balanceCalls = nil
+	stub.closeCalls = nil
+	stub.setNicknameCalls = nil
+	stub.summarizeCalls = nil
+	stub.withdrawCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = (*WithdrawableAccount)(nil)
@@ -0,0 +1,199 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package recordreturns
+
+import (
+	"context"
+	"github.com/dradtke/stubber/testdata/search"
+)
+
+// Index is a stubbed implementation of search.Index.
+type Index struct {
+	// ClearStub defines the implementation for Clear.
+	ClearStub  func()
+	clearCalls []struct{}
+	// CountStub defines the implementation for Count.
+	CountStub  func(terms ...string) (int, error)
+	countCalls []struct {
+		Terms []string
+		R0    int
+		R1    error
+	}
+	// SearchStub defines the implementation for Search.
+	SearchStub  func(ctx context.Context, terms ...string) ([]string, int, error)
+	searchCalls []struct {
+		Ctx   context.Context
+		Terms []string
+		Hits  []string
+		Total int
+		Err   error
+	}
+}
+
+// NewIndex returns a new Index without any stubs set.
+func NewIndex() *Index {
+	return &Index{}
+}
+
+// Clear delegates its behavior to the field ClearStub.
+func (s *Index) Clear() {
+	if s.ClearStub == nil {
+		panic("Index.Clear: nil method stub")
+	}
+	s.clearCalls = append(s.clearCalls, struct{}{})
+	(s.ClearStub)()
+}
+
+// ClearCalls returns a slice of calls made to Clear. Each element
+// of the slice represents the parameters that were provided.
+func (s *Index) ClearCalls() []struct{} {
+	return s.clearCalls
+}
+
+// ClearCallCount returns the number of calls made to Clear.
+func (s *Index) ClearCallCount() int {
+	return len(s.clearCalls)
+}
+
+// ClearCalled reports whether Clear has been called.
+func (s *Index) ClearCalled() bool {
+	return s.ClearCallCount() > 0
+}
+
+// ClearLastCall returns the parameters of the most recent call to Clear,
+// and whether there has been one.
+func (s *Index) ClearLastCall() (struct{}, bool) {
+	calls := s.ClearCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Count delegates its behavior to the field CountStub.
+func (s *Index) Count(terms ...string) (r0 int, r1 error) {
+	if s.CountStub == nil {
+		panic("Index.Count: nil method stub")
+	}
+	defer func() {
+		s.countCalls = append(s.countCalls, struct {
+			Terms []string
+			R0    int
+			R1    error
+		}{Terms: terms, R0: r0, R1: r1})
+	}()
+	return (s.CountStub)(terms...)
+}
+
+// CountCalls returns a slice of calls made to Count. Each element
+// of the slice represents the parameters that were provided.
+func (s *Index) CountCalls() []struct {
+	Terms []string
+	R0    int
+	R1    error
+} {
+	return s.countCalls
+}
+
+// CountCallCount returns the number of calls made to Count.
+func (s *Index) CountCallCount() int {
+	return len(s.countCalls)
+}
+
+// CountCalled reports whether Count has been called.
+func (s *Index) CountCalled() bool {
+	return s.CountCallCount() > 0
+}
+
+// CountLastCall returns the parameters of the most recent call to Count,
+// and whether there has been one.
+func (s *Index) CountLastCall() (struct {
+	Terms []string
+	R0    int
+	R1    error
+}, bool) {
+	calls := s.CountCalls()
+	if len(calls) == 0 {
+		return struct {
+			Terms []string
+			R0    int
+			R1    error
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Search delegates its behavior to the field SearchStub.
+func (s *Index) Search(ctx context.Context, terms ...string) (r0 []string, r1 int, r2 error) {
+	if s.SearchStub == nil {
+		panic("Index.Search: nil method stub")
+	}
+	defer func() {
+		s.searchCalls = append(s.searchCalls, struct {
+			Ctx   context.Context
+			Terms []string
+			Hits  []string
+			Total int
+			Err   error
+		}{Ctx: ctx, Terms: terms, Hits: r0, Total: r1, Err: r2})
+	}()
+	return (s.SearchStub)(ctx, terms...)
+}
+
+// SearchCalls returns a slice of calls made to Search. Each element
+// of the slice represents the parameters that were provided.
+func (s *Index) SearchCalls() []struct {
+	Ctx   context.Context
+	Terms []string
+	Hits  []string
+	Total int
+	Err   error
+} {
+	return s.searchCalls
+}
+
+// SearchCallCount returns the number of calls made to Search.
+func (s *Index) SearchCallCount() int {
+	return len(s.searchCalls)
+}
+
+// SearchCalled reports whether Search has been called.
+func (s *Index) SearchCalled() bool {
+	return s.SearchCallCount() > 0
+}
+
+// SearchLastCall returns the parameters of the most recent call to Search,
+// and whether there has been one.
+func (s *Index) SearchLastCall() (struct {
+	Ctx   context.Context
+	Terms []string
+	Hits  []string
+	Total int
+	Err   error
+}, bool) {
+	calls := s.SearchCalls()
+	if len(calls) == 0 {
+		return struct {
+			Ctx   context.Context
+			Terms []string
+			Hits  []string
+			Total int
+			Err   error
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Index) Reset() {
+	s.clearCalls = nil
+	s.countCalls = nil
+	s.searchCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ search.Index = (*Index)(nil)
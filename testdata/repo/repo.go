@@ -0,0 +1,8 @@
+package repo
+
+// Repo has two type parameters with different constraints.
+type Repo[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V) error
+	Keys() []K
+}
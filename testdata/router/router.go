@@ -0,0 +1,9 @@
+package router
+
+import "net/http"
+
+// Router's methods only refer to http inside composite types.
+type Router interface {
+	Handlers() map[string]func(http.ResponseWriter, *http.Request)
+	Use(middleware ...func(http.Handler) http.Handler)
+}
@@ -0,0 +1,25 @@
+package rpc
+
+import "context"
+
+type Request struct {
+	Method string
+	Body   []byte
+}
+
+type Response struct {
+	Status int
+	Body   []byte
+}
+
+// Option configures a single call.
+type Option func(*CallOptions)
+
+type CallOptions struct {
+	Retries int
+}
+
+// Client has the canonical shape of a gRPC-style method.
+type Client interface {
+	Do(ctx context.Context, req *Request, opts ...Option) (*Response, error)
+}
@@ -0,0 +1,11 @@
+package search
+
+import "context"
+
+// Index has variadic methods with several results, some named and some not,
+// for recording what each call returned.
+type Index interface {
+	Search(ctx context.Context, terms ...string) (hits []string, total int, err error)
+	Count(terms ...string) (int, error)
+	Clear()
+}
@@ -0,0 +1,5 @@
+package selfstubs
+
+type Cache interface {
+	Get(key string) (string, error)
+}
@@ -0,0 +1,9 @@
+// This file looks like stubber's output, but lacks the nostubs constraint, so
+// it's part of the package when stubs are generated.
+
+package selfstubs
+
+// Stale must not be stubbed, since it's declared in a generated file.
+type Stale interface {
+	Refresh() error
+}
@@ -0,0 +1,7 @@
+package east
+
+// Store has the same name as west.Store, so their stubs are disambiguated
+// when they're written to a single file.
+type Store interface {
+	Put(key, value string) error
+}
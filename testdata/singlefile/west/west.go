@@ -0,0 +1,6 @@
+package west
+
+// Store has the same name as east.Store.
+type Store interface {
+	Get(key string) (string, bool)
+}
@@ -0,0 +1,15 @@
+package statfs
+
+import (
+	"os"
+	"unsafe"
+)
+
+// Statter refers to os.FileInfo, an alias of fs.FileInfo, and to
+// unsafe.Pointer, whose packages must be imported, and to the universe's any
+// and error, which mustn't be.
+type Statter interface {
+	Stat(name string) (os.FileInfo, error)
+	Sys(v any) any
+	Addr() unsafe.Pointer
+}
@@ -0,0 +1,11 @@
+package stream
+
+import "io"
+
+// Stream embeds interfaces from another package, whose methods must all be
+// stubbed. WriteTo refers to io.Writer, so io must be imported.
+type Stream interface {
+	io.ReadWriteCloser
+	io.WriterTo
+	Flush() error
+}
@@ -0,0 +1,122 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/alias"
+)
+
+// ByteSource is a stubbed implementation of alias.ByteSource.
+type ByteSource struct {
+	// ReadByteStub defines the implementation for ReadByte.
+	ReadByteStub  func() (byte, error)
+	readByteCalls []struct{}
+}
+
+// NewByteSource returns a new ByteSource without any stubs set.
+func NewByteSource() *ByteSource {
+	return &ByteSource{}
+}
+
+// ReadByte delegates its behavior to the field ReadByteStub.
+func (s *ByteSource) ReadByte() (byte, error) {
+	if s.ReadByteStub == nil {
+		panic("ByteSource.ReadByte: nil method stub")
+	}
+	s.readByteCalls = append(s.readByteCalls, struct{}{})
+	return (s.ReadByteStub)()
+}
+
+// ReadByteCalls returns a slice of calls made to ReadByte. Each element
+// of the slice represents the parameters that were provided.
+func (s *ByteSource) ReadByteCalls() []struct{} {
+	return s.readByteCalls
+}
+
+// ReadByteCallCount returns the number of calls made to ReadByte.
+func (s *ByteSource) ReadByteCallCount() int {
+	return len(s.readByteCalls)
+}
+
+// ReadByteCalled reports whether ReadByte has been called.
+func (s *ByteSource) ReadByteCalled() bool {
+	return s.ReadByteCallCount() > 0
+}
+
+// ReadByteLastCall returns the parameters of the most recent call to ReadByte,
+// and whether there has been one.
+func (s *ByteSource) ReadByteLastCall() (struct{}, bool) {
+	calls := s.ReadByteCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *ByteSource) Reset() {
+	s.readByteCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ alias.ByteSource = (*ByteSource)(nil)
+
+// Flusher is a stubbed implementation of alias.Flusher.
+type Flusher struct {
+	// FlushStub defines the implementation for Flush.
+	FlushStub  func() error
+	flushCalls []struct{}
+}
+
+// NewFlusher returns a new Flusher without any stubs set.
+func NewFlusher() *Flusher {
+	return &Flusher{}
+}
+
+// Flush delegates its behavior to the field FlushStub.
+func (s *Flusher) Flush() error {
+	if s.FlushStub == nil {
+		panic("Flusher.Flush: nil method stub")
+	}
+	s.flushCalls = append(s.flushCalls, struct{}{})
+	return (s.FlushStub)()
+}
+
+// FlushCalls returns a slice of calls made to Flush. Each element
+// of the slice represents the parameters that were provided.
+func (s *Flusher) FlushCalls() []struct{} {
+	return s.flushCalls
+}
+
+// FlushCallCount returns the number of calls made to Flush.
+func (s *Flusher) FlushCallCount() int {
+	return len(s.flushCalls)
+}
+
+// FlushCalled reports whether Flush has been called.
+func (s *Flusher) FlushCalled() bool {
+	return s.FlushCallCount() > 0
+}
+
+// FlushLastCall returns the parameters of the most recent call to Flush,
+// and whether there has been one.
+func (s *Flusher) FlushLastCall() (struct{}, bool) {
+	calls := s.FlushCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Flusher) Reset() {
+	s.flushCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ alias.Flusher = (*Flusher)(nil)
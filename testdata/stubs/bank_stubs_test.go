@@ -0,0 +1,52 @@
+// This file was scaffolded by stubber from recorded calls. Fill in the TODOs
+// to exercise the code under test.
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestWithdrawableAccountRecording checks that the calls made to a
+// WithdrawableAccount match those recorded from bank.WithdrawableAccount.
+func TestWithdrawableAccountRecording(t *testing.T) {
+	s := &WithdrawableAccount{
+		BalanceStub: func() (_ int) {
+			return // TODO: return the recorded results
+		},
+		WithdrawStub: func(amount int) (_ int, _ error) {
+			return // TODO: return the recorded results
+		},
+	}
+
+	// TODO: exercise the code under test using s.
+
+	for _, c := range []struct {
+		method string
+		calls  interface{}
+		want   string
+	}{
+		{"Balance", s.BalanceCalls(), `[{},{}]`},
+		{"Withdraw", s.WithdrawCalls(), `[{"Amount":10},{"Amount":25}]`},
+	} {
+		got, err := json.Marshal(c.calls)
+		if err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		var gotCalls, wantCalls interface{}
+		if err := json.Unmarshal(got, &gotCalls); err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		if err := json.Unmarshal([]byte(c.want), &wantCalls); err != nil {
+			t.Fatalf("%s: %s", c.method, err)
+		}
+		if !reflect.DeepEqual(gotCalls, wantCalls) {
+			t.Errorf("%s: calls mismatch\n got: %s\nwant: %s", c.method, got, c.want)
+		}
+	}
+}
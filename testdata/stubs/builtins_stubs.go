@@ -0,0 +1,242 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/builtins"
+)
+
+// Checker is a stubbed implementation of builtins.Checker.
+type Checker struct {
+	// CheckStub defines the implementation for Check.
+	CheckStub  func(name string, data []byte, r rune) error
+	checkCalls []struct {
+		Name string
+		Data []byte
+		R    rune
+	}
+	// ErrorsStub defines the implementation for Errors.
+	ErrorsStub  func() []error
+	errorsCalls []struct{}
+	// LookupStub defines the implementation for Lookup.
+	LookupStub  func(m map[string]error) (complex128, error)
+	lookupCalls []struct{ M map[string]error }
+	// StatsStub defines the implementation for Stats.
+	StatsStub  func() (int, float64, uint64)
+	statsCalls []struct{}
+	// ValidStub defines the implementation for Valid.
+	ValidStub  func(v interface{}) bool
+	validCalls []struct{ V interface{} }
+}
+
+// NewChecker returns a new Checker without any stubs set.
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Check delegates its behavior to the field CheckStub.
+func (s *Checker) Check(name string, data []byte, r rune) error {
+	if s.CheckStub == nil {
+		panic("Checker.Check: nil method stub")
+	}
+	s.checkCalls = append(s.checkCalls, struct {
+		Name string
+		Data []byte
+		R    rune
+	}{Name: name, Data: data, R: r})
+	return (s.CheckStub)(name, data, r)
+}
+
+// CheckCalls returns a slice of calls made to Check. Each element
+// of the slice represents the parameters that were provided.
+func (s *Checker) CheckCalls() []struct {
+	Name string
+	Data []byte
+	R    rune
+} {
+	return s.checkCalls
+}
+
+// CheckCallCount returns the number of calls made to Check.
+func (s *Checker) CheckCallCount() int {
+	return len(s.checkCalls)
+}
+
+// CheckCalled reports whether Check has been called.
+func (s *Checker) CheckCalled() bool {
+	return s.CheckCallCount() > 0
+}
+
+// CheckLastCall returns the parameters of the most recent call to Check,
+// and whether there has been one.
+func (s *Checker) CheckLastCall() (struct {
+	Name string
+	Data []byte
+	R    rune
+}, bool) {
+	calls := s.CheckCalls()
+	if len(calls) == 0 {
+		return struct {
+			Name string
+			Data []byte
+			R    rune
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Errors delegates its behavior to the field ErrorsStub.
+func (s *Checker) Errors() []error {
+	if s.ErrorsStub == nil {
+		panic("Checker.Errors: nil method stub")
+	}
+	s.errorsCalls = append(s.errorsCalls, struct{}{})
+	return (s.ErrorsStub)()
+}
+
+// ErrorsCalls returns a slice of calls made to Errors. Each element
+// of the slice represents the parameters that were provided.
+func (s *Checker) ErrorsCalls() []struct{} {
+	return s.errorsCalls
+}
+
+// ErrorsCallCount returns the number of calls made to Errors.
+func (s *Checker) ErrorsCallCount() int {
+	return len(s.errorsCalls)
+}
+
+// ErrorsCalled reports whether Errors has been called.
+func (s *Checker) ErrorsCalled() bool {
+	return s.ErrorsCallCount() > 0
+}
+
+// ErrorsLastCall returns the parameters of the most recent call to Errors,
+// and whether there has been one.
+func (s *Checker) ErrorsLastCall() (struct{}, bool) {
+	calls := s.ErrorsCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Lookup delegates its behavior to the field LookupStub.
+func (s *Checker) Lookup(m map[string]error) (complex128, error) {
+	if s.LookupStub == nil {
+		panic("Checker.Lookup: nil method stub")
+	}
+	s.lookupCalls = append(s.lookupCalls, struct{ M map[string]error }{M: m})
+	return (s.LookupStub)(m)
+}
+
+// LookupCalls returns a slice of calls made to Lookup. Each element
+// of the slice represents the parameters that were provided.
+func (s *Checker) LookupCalls() []struct{ M map[string]error } {
+	return s.lookupCalls
+}
+
+// LookupCallCount returns the number of calls made to Lookup.
+func (s *Checker) LookupCallCount() int {
+	return len(s.lookupCalls)
+}
+
+// LookupCalled reports whether Lookup has been called.
+func (s *Checker) LookupCalled() bool {
+	return s.LookupCallCount() > 0
+}
+
+// LookupLastCall returns the parameters of the most recent call to Lookup,
+// and whether there has been one.
+func (s *Checker) LookupLastCall() (struct{ M map[string]error }, bool) {
+	calls := s.LookupCalls()
+	if len(calls) == 0 {
+		return struct{ M map[string]error }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Stats delegates its behavior to the field StatsStub.
+func (s *Checker) Stats() (int, float64, uint64) {
+	if s.StatsStub == nil {
+		panic("Checker.Stats: nil method stub")
+	}
+	s.statsCalls = append(s.statsCalls, struct{}{})
+	return (s.StatsStub)()
+}
+
+// StatsCalls returns a slice of calls made to Stats. Each element
+// of the slice represents the parameters that were provided.
+func (s *Checker) StatsCalls() []struct{} {
+	return s.statsCalls
+}
+
+// StatsCallCount returns the number of calls made to Stats.
+func (s *Checker) StatsCallCount() int {
+	return len(s.statsCalls)
+}
+
+// StatsCalled reports whether Stats has been called.
+func (s *Checker) StatsCalled() bool {
+	return s.StatsCallCount() > 0
+}
+
+// StatsLastCall returns the parameters of the most recent call to Stats,
+// and whether there has been one.
+func (s *Checker) StatsLastCall() (struct{}, bool) {
+	calls := s.StatsCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Valid delegates its behavior to the field ValidStub.
+func (s *Checker) Valid(v interface{}) bool {
+	if s.ValidStub == nil {
+		panic("Checker.Valid: nil method stub")
+	}
+	s.validCalls = append(s.validCalls, struct{ V interface{} }{V: v})
+	return (s.ValidStub)(v)
+}
+
+// ValidCalls returns a slice of calls made to Valid. Each element
+// of the slice represents the parameters that were provided.
+func (s *Checker) ValidCalls() []struct{ V interface{} } {
+	return s.validCalls
+}
+
+// ValidCallCount returns the number of calls made to Valid.
+func (s *Checker) ValidCallCount() int {
+	return len(s.validCalls)
+}
+
+// ValidCalled reports whether Valid has been called.
+func (s *Checker) ValidCalled() bool {
+	return s.ValidCallCount() > 0
+}
+
+// ValidLastCall returns the parameters of the most recent call to Valid,
+// and whether there has been one.
+func (s *Checker) ValidLastCall() (struct{ V interface{} }, bool) {
+	calls := s.ValidCalls()
+	if len(calls) == 0 {
+		return struct{ V interface{} }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Checker) Reset() {
+	s.checkCalls = nil
+	s.errorsCalls = nil
+	s.lookupCalls = nil
+	s.statsCalls = nil
+	s.validCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ builtins.Checker = (*Checker)(nil)
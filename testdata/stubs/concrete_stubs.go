@@ -0,0 +1,132 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/concrete"
+)
+
+// MailerInterface is the method set of concrete.Mailer, which Mailer
+// implements.
+type MailerInterface interface {
+	Host() string
+	Send(addr string, body string) error
+}
+
+// Mailer is a stubbed implementation of concrete.Mailer.
+type Mailer struct {
+	// HostStub defines the implementation for Host.
+	HostStub  func() string
+	hostCalls []struct{}
+	// SendStub defines the implementation for Send.
+	SendStub  func(addr string, body string) error
+	sendCalls []struct {
+		Addr string
+		Body string
+	}
+}
+
+// NewMailer returns a new Mailer without any stubs set.
+func NewMailer() *Mailer {
+	return &Mailer{}
+}
+
+// Host returns the host of the mail server.
+//
+// Host delegates its behavior to the field HostStub.
+func (s *Mailer) Host() string {
+	if s.HostStub == nil {
+		panic("Mailer.Host: nil method stub")
+	}
+	s.hostCalls = append(s.hostCalls, struct{}{})
+	return (s.HostStub)()
+}
+
+// HostCalls returns a slice of calls made to Host. Each element
+// of the slice represents the parameters that were provided.
+func (s *Mailer) HostCalls() []struct{} {
+	return s.hostCalls
+}
+
+// HostCallCount returns the number of calls made to Host.
+func (s *Mailer) HostCallCount() int {
+	return len(s.hostCalls)
+}
+
+// HostCalled reports whether Host has been called.
+func (s *Mailer) HostCalled() bool {
+	return s.HostCallCount() > 0
+}
+
+// HostLastCall returns the parameters of the most recent call to Host,
+// and whether there has been one.
+func (s *Mailer) HostLastCall() (struct{}, bool) {
+	calls := s.HostCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Send sends body to addr.
+//
+// Send delegates its behavior to the field SendStub.
+func (s *Mailer) Send(addr string, body string) error {
+	if s.SendStub == nil {
+		panic("Mailer.Send: nil method stub")
+	}
+	s.sendCalls = append(s.sendCalls, struct {
+		Addr string
+		Body string
+	}{Addr: addr, Body: body})
+	return (s.SendStub)(addr, body)
+}
+
+// SendCalls returns a slice of calls made to Send. Each element
+// of the slice represents the parameters that were provided.
+func (s *Mailer) SendCalls() []struct {
+	Addr string
+	Body string
+} {
+	return s.sendCalls
+}
+
+// SendCallCount returns the number of calls made to Send.
+func (s *Mailer) SendCallCount() int {
+	return len(s.sendCalls)
+}
+
+// SendCalled reports whether Send has been called.
+func (s *Mailer) SendCalled() bool {
+	return s.SendCallCount() > 0
+}
+
+// SendLastCall returns the parameters of the most recent call to Send,
+// and whether there has been one.
+func (s *Mailer) SendLastCall() (struct {
+	Addr string
+	Body string
+}, bool) {
+	calls := s.SendCalls()
+	if len(calls) == 0 {
+		return struct {
+			Addr string
+			Body string
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Mailer) Reset() {
+	s.hostCalls = nil
+	s.sendCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ MailerInterface = (*Mailer)(nil)
+var _ MailerInterface = (*concrete.Mailer)(nil)
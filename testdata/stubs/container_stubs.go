@@ -0,0 +1,152 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"fmt"
+	"github.com/dradtke/stubber/testdata/container"
+)
+
+// Container is a stubbed implementation of container.Container.
+type Container[T fmt.Stringer] struct {
+	// AddStub defines the implementation for Add.
+	AddStub  func(item T) error
+	addCalls []struct{ Item T }
+	// FirstStub defines the implementation for First.
+	FirstStub  func() (T, bool)
+	firstCalls []struct{}
+	// LenStub defines the implementation for Len.
+	LenStub  func() int
+	lenCalls []struct{}
+}
+
+// NewContainer returns a new Container without any stubs set.
+func NewContainer[T fmt.Stringer]() *Container[T] {
+	return &Container[T]{}
+}
+
+// Add adds item to the container.
+//
+// Add delegates its behavior to the field AddStub.
+func (s *Container[T]) Add(item T) error {
+	if s.AddStub == nil {
+		panic("Container.Add: nil method stub")
+	}
+	s.addCalls = append(s.addCalls, struct{ Item T }{Item: item})
+	return (s.AddStub)(item)
+}
+
+// AddCalls returns a slice of calls made to Add. Each element
+// of the slice represents the parameters that were provided.
+func (s *Container[T]) AddCalls() []struct{ Item T } {
+	return s.addCalls
+}
+
+// AddCallCount returns the number of calls made to Add.
+func (s *Container[T]) AddCallCount() int {
+	return len(s.addCalls)
+}
+
+// AddCalled reports whether Add has been called.
+func (s *Container[T]) AddCalled() bool {
+	return s.AddCallCount() > 0
+}
+
+// AddLastCall returns the parameters of the most recent call to Add,
+// and whether there has been one.
+func (s *Container[T]) AddLastCall() (struct{ Item T }, bool) {
+	calls := s.AddCalls()
+	if len(calls) == 0 {
+		return struct{ Item T }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// First returns the first item in the container, and false if it's
+// empty.
+//
+// First delegates its behavior to the field FirstStub.
+func (s *Container[T]) First() (T, bool) {
+	if s.FirstStub == nil {
+		panic("Container.First: nil method stub")
+	}
+	s.firstCalls = append(s.firstCalls, struct{}{})
+	return (s.FirstStub)()
+}
+
+// FirstCalls returns a slice of calls made to First. Each element
+// of the slice represents the parameters that were provided.
+func (s *Container[T]) FirstCalls() []struct{} {
+	return s.firstCalls
+}
+
+// FirstCallCount returns the number of calls made to First.
+func (s *Container[T]) FirstCallCount() int {
+	return len(s.firstCalls)
+}
+
+// FirstCalled reports whether First has been called.
+func (s *Container[T]) FirstCalled() bool {
+	return s.FirstCallCount() > 0
+}
+
+// FirstLastCall returns the parameters of the most recent call to First,
+// and whether there has been one.
+func (s *Container[T]) FirstLastCall() (struct{}, bool) {
+	calls := s.FirstCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Len delegates its behavior to the field LenStub.
+func (s *Container[T]) Len() int {
+	if s.LenStub == nil {
+		panic("Container.Len: nil method stub")
+	}
+	s.lenCalls = append(s.lenCalls, struct{}{})
+	return (s.LenStub)()
+}
+
+// LenCalls returns a slice of calls made to Len. Each element
+// of the slice represents the parameters that were provided.
+func (s *Container[T]) LenCalls() []struct{} {
+	return s.lenCalls
+}
+
+// LenCallCount returns the number of calls made to Len.
+func (s *Container[T]) LenCallCount() int {
+	return len(s.lenCalls)
+}
+
+// LenCalled reports whether Len has been called.
+func (s *Container[T]) LenCalled() bool {
+	return s.LenCallCount() > 0
+}
+
+// LenLastCall returns the parameters of the most recent call to Len,
+// and whether there has been one.
+func (s *Container[T]) LenLastCall() (struct{}, bool) {
+	calls := s.LenCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Container[T]) Reset() {
+	s.addCalls = nil
+	s.firstCalls = nil
+	s.lenCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+func _[T fmt.Stringer]() {
+	var _ container.Container[T] = (*Container[T])(nil)
+}
@@ -0,0 +1,140 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/dupnames"
+)
+
+// Index is a stubbed implementation of dupnames.Index.
+type Index struct {
+	// GetStub defines the implementation for Get.
+	GetStub  func(key string, Key int) (string, error)
+	getCalls []struct {
+		Key  string
+		Key2 int
+	}
+	// MoveStub defines the implementation for Move.
+	MoveStub  func(url string, URL string, url2 bool)
+	moveCalls []struct {
+		Url  string
+		URL  string
+		Url2 bool
+	}
+}
+
+// NewIndex returns a new Index without any stubs set.
+func NewIndex() *Index {
+	return &Index{}
+}
+
+// Get delegates its behavior to the field GetStub.
+func (s *Index) Get(key string, Key int) (string, error) {
+	if s.GetStub == nil {
+		panic("Index.Get: nil method stub")
+	}
+	s.getCalls = append(s.getCalls, struct {
+		Key  string
+		Key2 int
+	}{Key: key, Key2: Key})
+	return (s.GetStub)(key, Key)
+}
+
+// GetCalls returns a slice of calls made to Get. Each element
+// of the slice represents the parameters that were provided.
+func (s *Index) GetCalls() []struct {
+	Key  string
+	Key2 int
+} {
+	return s.getCalls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (s *Index) GetCallCount() int {
+	return len(s.getCalls)
+}
+
+// GetCalled reports whether Get has been called.
+func (s *Index) GetCalled() bool {
+	return s.GetCallCount() > 0
+}
+
+// GetLastCall returns the parameters of the most recent call to Get,
+// and whether there has been one.
+func (s *Index) GetLastCall() (struct {
+	Key  string
+	Key2 int
+}, bool) {
+	calls := s.GetCalls()
+	if len(calls) == 0 {
+		return struct {
+			Key  string
+			Key2 int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Move delegates its behavior to the field MoveStub.
+func (s *Index) Move(url string, URL string, url2 bool) {
+	if s.MoveStub == nil {
+		panic("Index.Move: nil method stub")
+	}
+	s.moveCalls = append(s.moveCalls, struct {
+		Url  string
+		URL  string
+		Url2 bool
+	}{Url: url, URL: URL, Url2: url2})
+	(s.MoveStub)(url, URL, url2)
+}
+
+// MoveCalls returns a slice of calls made to Move. Each element
+// of the slice represents the parameters that were provided.
+func (s *Index) MoveCalls() []struct {
+	Url  string
+	URL  string
+	Url2 bool
+} {
+	return s.moveCalls
+}
+
+// MoveCallCount returns the number of calls made to Move.
+func (s *Index) MoveCallCount() int {
+	return len(s.moveCalls)
+}
+
+// MoveCalled reports whether Move has been called.
+func (s *Index) MoveCalled() bool {
+	return s.MoveCallCount() > 0
+}
+
+// MoveLastCall returns the parameters of the most recent call to Move,
+// and whether there has been one.
+func (s *Index) MoveLastCall() (struct {
+	Url  string
+	URL  string
+	Url2 bool
+}, bool) {
+	calls := s.MoveCalls()
+	if len(calls) == 0 {
+		return struct {
+			Url  string
+			URL  string
+			Url2 bool
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Index) Reset() {
+	s.getCalls = nil
+	s.moveCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ dupnames.Index = (*Index)(nil)
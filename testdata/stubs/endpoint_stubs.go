@@ -0,0 +1,108 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"context"
+	"github.com/dradtke/stubber/testdata/endpoint"
+	"net"
+	"net/http"
+)
+
+// Endpoint is a stubbed implementation of endpoint.Endpoint.
+type Endpoint struct {
+	// DialerStub defines the implementation for Dialer.
+	DialerStub  func() func(ctx context.Context, network string, addr string) (conn net.Conn, err error)
+	dialerCalls []struct{}
+	// HandlerStub defines the implementation for Handler.
+	HandlerStub  func() func(w http.ResponseWriter, r *http.Request)
+	handlerCalls []struct{}
+}
+
+// NewEndpoint returns a new Endpoint without any stubs set.
+func NewEndpoint() *Endpoint {
+	return &Endpoint{}
+}
+
+// Dialer delegates its behavior to the field DialerStub.
+func (s *Endpoint) Dialer() func(ctx context.Context, network string, addr string) (conn net.Conn, err error) {
+	if s.DialerStub == nil {
+		panic("Endpoint.Dialer: nil method stub")
+	}
+	s.dialerCalls = append(s.dialerCalls, struct{}{})
+	return (s.DialerStub)()
+}
+
+// DialerCalls returns a slice of calls made to Dialer. Each element
+// of the slice represents the parameters that were provided.
+func (s *Endpoint) DialerCalls() []struct{} {
+	return s.dialerCalls
+}
+
+// DialerCallCount returns the number of calls made to Dialer.
+func (s *Endpoint) DialerCallCount() int {
+	return len(s.dialerCalls)
+}
+
+// DialerCalled reports whether Dialer has been called.
+func (s *Endpoint) DialerCalled() bool {
+	return s.DialerCallCount() > 0
+}
+
+// DialerLastCall returns the parameters of the most recent call to Dialer,
+// and whether there has been one.
+func (s *Endpoint) DialerLastCall() (struct{}, bool) {
+	calls := s.DialerCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Handler delegates its behavior to the field HandlerStub.
+func (s *Endpoint) Handler() func(w http.ResponseWriter, r *http.Request) {
+	if s.HandlerStub == nil {
+		panic("Endpoint.Handler: nil method stub")
+	}
+	s.handlerCalls = append(s.handlerCalls, struct{}{})
+	return (s.HandlerStub)()
+}
+
+// HandlerCalls returns a slice of calls made to Handler. Each element
+// of the slice represents the parameters that were provided.
+func (s *Endpoint) HandlerCalls() []struct{} {
+	return s.handlerCalls
+}
+
+// HandlerCallCount returns the number of calls made to Handler.
+func (s *Endpoint) HandlerCallCount() int {
+	return len(s.handlerCalls)
+}
+
+// HandlerCalled reports whether Handler has been called.
+func (s *Endpoint) HandlerCalled() bool {
+	return s.HandlerCallCount() > 0
+}
+
+// HandlerLastCall returns the parameters of the most recent call to Handler,
+// and whether there has been one.
+func (s *Endpoint) HandlerLastCall() (struct{}, bool) {
+	calls := s.HandlerCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Endpoint) Reset() {
+	s.dialerCalls = nil
+	s.handlerCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ endpoint.Endpoint = (*Endpoint)(nil)
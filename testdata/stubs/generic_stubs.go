@@ -0,0 +1,106 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/generic"
+	"time"
+)
+
+// Service is a stubbed implementation of generic.Service.
+type Service struct {
+	// LatestStub defines the implementation for Latest.
+	LatestStub  func() (generic.Pair[string, time.Time], bool)
+	latestCalls []struct{}
+	// ProcessStub defines the implementation for Process.
+	ProcessStub  func(items generic.List[generic.Item]) error
+	processCalls []struct{ Items generic.List[generic.Item] }
+}
+
+// NewService returns a new Service without any stubs set.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Latest delegates its behavior to the field LatestStub.
+func (s *Service) Latest() (generic.Pair[string, time.Time], bool) {
+	if s.LatestStub == nil {
+		panic("Service.Latest: nil method stub")
+	}
+	s.latestCalls = append(s.latestCalls, struct{}{})
+	return (s.LatestStub)()
+}
+
+// LatestCalls returns a slice of calls made to Latest. Each element
+// of the slice represents the parameters that were provided.
+func (s *Service) LatestCalls() []struct{} {
+	return s.latestCalls
+}
+
+// LatestCallCount returns the number of calls made to Latest.
+func (s *Service) LatestCallCount() int {
+	return len(s.latestCalls)
+}
+
+// LatestCalled reports whether Latest has been called.
+func (s *Service) LatestCalled() bool {
+	return s.LatestCallCount() > 0
+}
+
+// LatestLastCall returns the parameters of the most recent call to Latest,
+// and whether there has been one.
+func (s *Service) LatestLastCall() (struct{}, bool) {
+	calls := s.LatestCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Process delegates its behavior to the field ProcessStub.
+func (s *Service) Process(items generic.List[generic.Item]) error {
+	if s.ProcessStub == nil {
+		panic("Service.Process: nil method stub")
+	}
+	s.processCalls = append(s.processCalls, struct{ Items generic.List[generic.Item] }{Items: items})
+	return (s.ProcessStub)(items)
+}
+
+// ProcessCalls returns a slice of calls made to Process. Each element
+// of the slice represents the parameters that were provided.
+func (s *Service) ProcessCalls() []struct{ Items generic.List[generic.Item] } {
+	return s.processCalls
+}
+
+// ProcessCallCount returns the number of calls made to Process.
+func (s *Service) ProcessCallCount() int {
+	return len(s.processCalls)
+}
+
+// ProcessCalled reports whether Process has been called.
+func (s *Service) ProcessCalled() bool {
+	return s.ProcessCallCount() > 0
+}
+
+// ProcessLastCall returns the parameters of the most recent call to Process,
+// and whether there has been one.
+func (s *Service) ProcessLastCall() (struct{ Items generic.List[generic.Item] }, bool) {
+	calls := s.ProcessCalls()
+	if len(calls) == 0 {
+		return struct{ Items generic.List[generic.Item] }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Service) Reset() {
+	s.latestCalls = nil
+	s.processCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ generic.Service = (*Service)(nil)
@@ -0,0 +1,59 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+// Pager is a stubbed implementation of ignore.Pager.
+type Pager struct {
+	// NotifyStub defines the implementation for Notify.
+	NotifyStub  func(msg string) error
+	notifyCalls []struct{ Msg string }
+}
+
+// NewPager returns a new Pager without any stubs set.
+func NewPager() *Pager {
+	return &Pager{}
+}
+
+// Notify delegates its behavior to the field NotifyStub.
+func (s *Pager) Notify(msg string) error {
+	if s.NotifyStub == nil {
+		panic("Pager.Notify: nil method stub")
+	}
+	s.notifyCalls = append(s.notifyCalls, struct{ Msg string }{Msg: msg})
+	return (s.NotifyStub)(msg)
+}
+
+// NotifyCalls returns a slice of calls made to Notify. Each element
+// of the slice represents the parameters that were provided.
+func (s *Pager) NotifyCalls() []struct{ Msg string } {
+	return s.notifyCalls
+}
+
+// NotifyCallCount returns the number of calls made to Notify.
+func (s *Pager) NotifyCallCount() int {
+	return len(s.notifyCalls)
+}
+
+// NotifyCalled reports whether Notify has been called.
+func (s *Pager) NotifyCalled() bool {
+	return s.NotifyCallCount() > 0
+}
+
+// NotifyLastCall returns the parameters of the most recent call to Notify,
+// and whether there has been one.
+func (s *Pager) NotifyLastCall() (struct{ Msg string }, bool) {
+	calls := s.NotifyCalls()
+	if len(calls) == 0 {
+		return struct{ Msg string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Pager) Reset() {
+	s.notifyCalls = nil
+}
@@ -0,0 +1,318 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"github.com/dradtke/stubber/testdata/ledger"
+	"io"
+	"time"
+)
+
+// Ledger is a stubbed implementation of ledger.Ledger.
+type Ledger struct {
+	// AuditStub defines the implementation for Audit.
+	AuditStub  func() error
+	auditCalls []struct{}
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub  func() int
+	balanceCalls []struct{}
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// EntriesStub defines the implementation for Entries.
+	EntriesStub  func(since time.Time) []string
+	entriesCalls []struct{ Since time.Time }
+	// RecordStub defines the implementation for Record.
+	RecordStub  func(account bank.Account, amount int) error
+	recordCalls []struct {
+		Account bank.Account
+		Amount  int
+	}
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub  func(_s string)
+	setNicknameCalls []struct{ S string }
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub  func(w io.Writer)
+	summarizeCalls []struct{ W io.Writer }
+}
+
+// NewLedger returns a new Ledger without any stubs set.
+func NewLedger() *Ledger {
+	return &Ledger{}
+}
+
+// Audit delegates its behavior to the field AuditStub.
+func (s *Ledger) Audit() error {
+	if s.AuditStub == nil {
+		panic("Ledger.Audit: nil method stub")
+	}
+	s.auditCalls = append(s.auditCalls, struct{}{})
+	return (s.AuditStub)()
+}
+
+// AuditCalls returns a slice of calls made to Audit. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) AuditCalls() []struct{} {
+	return s.auditCalls
+}
+
+// AuditCallCount returns the number of calls made to Audit.
+func (s *Ledger) AuditCallCount() int {
+	return len(s.auditCalls)
+}
+
+// AuditCalled reports whether Audit has been called.
+func (s *Ledger) AuditCalled() bool {
+	return s.AuditCallCount() > 0
+}
+
+// AuditLastCall returns the parameters of the most recent call to Audit,
+// and whether there has been one.
+func (s *Ledger) AuditLastCall() (struct{}, bool) {
+	calls := s.AuditCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s *Ledger) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Ledger.Balance: nil method stub")
+	}
+	s.balanceCalls = append(s.balanceCalls, struct{}{})
+	return (s.BalanceStub)()
+}
+
+// BalanceCalls returns a slice of calls made to Balance. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) BalanceCalls() []struct{} {
+	return s.balanceCalls
+}
+
+// BalanceCallCount returns the number of calls made to Balance.
+func (s *Ledger) BalanceCallCount() int {
+	return len(s.balanceCalls)
+}
+
+// BalanceCalled reports whether Balance has been called.
+func (s *Ledger) BalanceCalled() bool {
+	return s.BalanceCallCount() > 0
+}
+
+// BalanceLastCall returns the parameters of the most recent call to Balance,
+// and whether there has been one.
+func (s *Ledger) BalanceLastCall() (struct{}, bool) {
+	calls := s.BalanceCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Ledger) Close() error {
+	if s.CloseStub == nil {
+		panic("Ledger.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Ledger) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Ledger) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Ledger) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Entries delegates its behavior to the field EntriesStub.
+func (s *Ledger) Entries(since time.Time) []string {
+	if s.EntriesStub == nil {
+		panic("Ledger.Entries: nil method stub")
+	}
+	s.entriesCalls = append(s.entriesCalls, struct{ Since time.Time }{Since: since})
+	return (s.EntriesStub)(since)
+}
+
+// EntriesCalls returns a slice of calls made to Entries. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) EntriesCalls() []struct{ Since time.Time } {
+	return s.entriesCalls
+}
+
+// EntriesCallCount returns the number of calls made to Entries.
+func (s *Ledger) EntriesCallCount() int {
+	return len(s.entriesCalls)
+}
+
+// EntriesCalled reports whether Entries has been called.
+func (s *Ledger) EntriesCalled() bool {
+	return s.EntriesCallCount() > 0
+}
+
+// EntriesLastCall returns the parameters of the most recent call to Entries,
+// and whether there has been one.
+func (s *Ledger) EntriesLastCall() (struct{ Since time.Time }, bool) {
+	calls := s.EntriesCalls()
+	if len(calls) == 0 {
+		return struct{ Since time.Time }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Record delegates its behavior to the field RecordStub.
+func (s *Ledger) Record(account bank.Account, amount int) error {
+	if s.RecordStub == nil {
+		panic("Ledger.Record: nil method stub")
+	}
+	s.recordCalls = append(s.recordCalls, struct {
+		Account bank.Account
+		Amount  int
+	}{Account: account, Amount: amount})
+	return (s.RecordStub)(account, amount)
+}
+
+// RecordCalls returns a slice of calls made to Record. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) RecordCalls() []struct {
+	Account bank.Account
+	Amount  int
+} {
+	return s.recordCalls
+}
+
+// RecordCallCount returns the number of calls made to Record.
+func (s *Ledger) RecordCallCount() int {
+	return len(s.recordCalls)
+}
+
+// RecordCalled reports whether Record has been called.
+func (s *Ledger) RecordCalled() bool {
+	return s.RecordCallCount() > 0
+}
+
+// RecordLastCall returns the parameters of the most recent call to Record,
+// and whether there has been one.
+func (s *Ledger) RecordLastCall() (struct {
+	Account bank.Account
+	Amount  int
+}, bool) {
+	calls := s.RecordCalls()
+	if len(calls) == 0 {
+		return struct {
+			Account bank.Account
+			Amount  int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s *Ledger) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Ledger.SetNickname: nil method stub")
+	}
+	s.setNicknameCalls = append(s.setNicknameCalls, struct{ S string }{S: _s})
+	(s.SetNicknameStub)(_s)
+}
+
+// SetNicknameCalls returns a slice of calls made to SetNickname. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) SetNicknameCalls() []struct{ S string } {
+	return s.setNicknameCalls
+}
+
+// SetNicknameCallCount returns the number of calls made to SetNickname.
+func (s *Ledger) SetNicknameCallCount() int {
+	return len(s.setNicknameCalls)
+}
+
+// SetNicknameCalled reports whether SetNickname has been called.
+func (s *Ledger) SetNicknameCalled() bool {
+	return s.SetNicknameCallCount() > 0
+}
+
+// SetNicknameLastCall returns the parameters of the most recent call to SetNickname,
+// and whether there has been one.
+func (s *Ledger) SetNicknameLastCall() (struct{ S string }, bool) {
+	calls := s.SetNicknameCalls()
+	if len(calls) == 0 {
+		return struct{ S string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s *Ledger) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Ledger.Summarize: nil method stub")
+	}
+	s.summarizeCalls = append(s.summarizeCalls, struct{ W io.Writer }{W: w})
+	(s.SummarizeStub)(w)
+}
+
+// SummarizeCalls returns a slice of calls made to Summarize. Each element
+// of the slice represents the parameters that were provided.
+func (s *Ledger) SummarizeCalls() []struct{ W io.Writer } {
+	return s.summarizeCalls
+}
+
+// SummarizeCallCount returns the number of calls made to Summarize.
+func (s *Ledger) SummarizeCallCount() int {
+	return len(s.summarizeCalls)
+}
+
+// SummarizeCalled reports whether Summarize has been called.
+func (s *Ledger) SummarizeCalled() bool {
+	return s.SummarizeCallCount() > 0
+}
+
+// SummarizeLastCall returns the parameters of the most recent call to Summarize,
+// and whether there has been one.
+func (s *Ledger) SummarizeLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.SummarizeCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Ledger) Reset() {
+	s.auditCalls = nil
+	s.balanceCalls = nil
+	s.closeCalls = nil
+	s.entriesCalls = nil
+	s.recordCalls = nil
+	s.setNicknameCalls = nil
+	s.summarizeCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ ledger.Ledger = (*Ledger)(nil)
@@ -0,0 +1,105 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/loader"
+)
+
+// Loader is a stubbed implementation of loader.Loader.
+type Loader struct {
+	// LoadStub_ defines the implementation for Load.
+	LoadStub_ func() error
+	loadCalls []struct{}
+	// LoadStubStub defines the implementation for LoadStub.
+	LoadStubStub  func() error
+	loadStubCalls []struct{}
+}
+
+// NewLoader returns a new Loader without any stubs set.
+func NewLoader() *Loader {
+	return &Loader{}
+}
+
+// Load delegates its behavior to the field LoadStub_.
+func (s *Loader) Load() error {
+	if s.LoadStub_ == nil {
+		panic("Loader.Load: nil method stub")
+	}
+	s.loadCalls = append(s.loadCalls, struct{}{})
+	return (s.LoadStub_)()
+}
+
+// LoadCalls returns a slice of calls made to Load. Each element
+// of the slice represents the parameters that were provided.
+func (s *Loader) LoadCalls() []struct{} {
+	return s.loadCalls
+}
+
+// LoadCallCount returns the number of calls made to Load.
+func (s *Loader) LoadCallCount() int {
+	return len(s.loadCalls)
+}
+
+// LoadCalled reports whether Load has been called.
+func (s *Loader) LoadCalled() bool {
+	return s.LoadCallCount() > 0
+}
+
+// LoadLastCall returns the parameters of the most recent call to Load,
+// and whether there has been one.
+func (s *Loader) LoadLastCall() (struct{}, bool) {
+	calls := s.LoadCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// LoadStub delegates its behavior to the field LoadStubStub.
+func (s *Loader) LoadStub() error {
+	if s.LoadStubStub == nil {
+		panic("Loader.LoadStub: nil method stub")
+	}
+	s.loadStubCalls = append(s.loadStubCalls, struct{}{})
+	return (s.LoadStubStub)()
+}
+
+// LoadStubCalls returns a slice of calls made to LoadStub. Each element
+// of the slice represents the parameters that were provided.
+func (s *Loader) LoadStubCalls() []struct{} {
+	return s.loadStubCalls
+}
+
+// LoadStubCallCount returns the number of calls made to LoadStub.
+func (s *Loader) LoadStubCallCount() int {
+	return len(s.loadStubCalls)
+}
+
+// LoadStubCalled reports whether LoadStub has been called.
+func (s *Loader) LoadStubCalled() bool {
+	return s.LoadStubCallCount() > 0
+}
+
+// LoadStubLastCall returns the parameters of the most recent call to LoadStub,
+// and whether there has been one.
+func (s *Loader) LoadStubLastCall() (struct{}, bool) {
+	calls := s.LoadStubCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Loader) Reset() {
+	s.loadCalls = nil
+	s.loadStubCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ loader.Loader = (*Loader)(nil)
@@ -0,0 +1,144 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/locker"
+)
+
+// LockedCache is a stubbed implementation of locker.LockedCache.
+type LockedCache struct {
+	// GetStub defines the implementation for Get.
+	GetStub  func(key string) (interface{}, bool)
+	getCalls []struct{ Key string }
+	// LockStub defines the implementation for Lock.
+	LockStub  func()
+	lockCalls []struct{}
+	// UnlockStub defines the implementation for Unlock.
+	UnlockStub  func()
+	unlockCalls []struct{}
+}
+
+// NewLockedCache returns a new LockedCache without any stubs set.
+func NewLockedCache() *LockedCache {
+	return &LockedCache{}
+}
+
+// Get delegates its behavior to the field GetStub.
+func (s *LockedCache) Get(key string) (interface{}, bool) {
+	if s.GetStub == nil {
+		panic("LockedCache.Get: nil method stub")
+	}
+	s.getCalls = append(s.getCalls, struct{ Key string }{Key: key})
+	return (s.GetStub)(key)
+}
+
+// GetCalls returns a slice of calls made to Get. Each element
+// of the slice represents the parameters that were provided.
+func (s *LockedCache) GetCalls() []struct{ Key string } {
+	return s.getCalls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (s *LockedCache) GetCallCount() int {
+	return len(s.getCalls)
+}
+
+// GetCalled reports whether Get has been called.
+func (s *LockedCache) GetCalled() bool {
+	return s.GetCallCount() > 0
+}
+
+// GetLastCall returns the parameters of the most recent call to Get,
+// and whether there has been one.
+func (s *LockedCache) GetLastCall() (struct{ Key string }, bool) {
+	calls := s.GetCalls()
+	if len(calls) == 0 {
+		return struct{ Key string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Lock delegates its behavior to the field LockStub.
+func (s *LockedCache) Lock() {
+	if s.LockStub == nil {
+		panic("LockedCache.Lock: nil method stub")
+	}
+	s.lockCalls = append(s.lockCalls, struct{}{})
+	(s.LockStub)()
+}
+
+// LockCalls returns a slice of calls made to Lock. Each element
+// of the slice represents the parameters that were provided.
+func (s *LockedCache) LockCalls() []struct{} {
+	return s.lockCalls
+}
+
+// LockCallCount returns the number of calls made to Lock.
+func (s *LockedCache) LockCallCount() int {
+	return len(s.lockCalls)
+}
+
+// LockCalled reports whether Lock has been called.
+func (s *LockedCache) LockCalled() bool {
+	return s.LockCallCount() > 0
+}
+
+// LockLastCall returns the parameters of the most recent call to Lock,
+// and whether there has been one.
+func (s *LockedCache) LockLastCall() (struct{}, bool) {
+	calls := s.LockCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Unlock delegates its behavior to the field UnlockStub.
+func (s *LockedCache) Unlock() {
+	if s.UnlockStub == nil {
+		panic("LockedCache.Unlock: nil method stub")
+	}
+	s.unlockCalls = append(s.unlockCalls, struct{}{})
+	(s.UnlockStub)()
+}
+
+// UnlockCalls returns a slice of calls made to Unlock. Each element
+// of the slice represents the parameters that were provided.
+func (s *LockedCache) UnlockCalls() []struct{} {
+	return s.unlockCalls
+}
+
+// UnlockCallCount returns the number of calls made to Unlock.
+func (s *LockedCache) UnlockCallCount() int {
+	return len(s.unlockCalls)
+}
+
+// UnlockCalled reports whether Unlock has been called.
+func (s *LockedCache) UnlockCalled() bool {
+	return s.UnlockCallCount() > 0
+}
+
+// UnlockLastCall returns the parameters of the most recent call to Unlock,
+// and whether there has been one.
+func (s *LockedCache) UnlockLastCall() (struct{}, bool) {
+	calls := s.UnlockCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *LockedCache) Reset() {
+	s.getCalls = nil
+	s.lockCalls = nil
+	s.unlockCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ locker.LockedCache = (*LockedCache)(nil)
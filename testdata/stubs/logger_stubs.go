@@ -0,0 +1,159 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/logger"
+)
+
+// Logger is a stubbed implementation of logger.Logger.
+type Logger struct {
+	// EnabledStub defines the implementation for Enabled.
+	EnabledStub  func(level int) bool
+	enabledCalls []struct{ Level int }
+	// PrintfStub defines the implementation for Printf.
+	PrintfStub  func(format string, args ...interface{})
+	printfCalls []struct {
+		Format string
+		Args   []interface{}
+	}
+	// PrintlnStub defines the implementation for Println.
+	PrintlnStub  func(args ...interface{})
+	printlnCalls []struct{ Args []interface{} }
+}
+
+// NewLogger returns a new Logger without any stubs set.
+func NewLogger() *Logger {
+	return &Logger{}
+}
+
+// Enabled delegates its behavior to the field EnabledStub.
+func (s *Logger) Enabled(level int) bool {
+	if s.EnabledStub == nil {
+		panic("Logger.Enabled: nil method stub")
+	}
+	s.enabledCalls = append(s.enabledCalls, struct{ Level int }{Level: level})
+	return (s.EnabledStub)(level)
+}
+
+// EnabledCalls returns a slice of calls made to Enabled. Each element
+// of the slice represents the parameters that were provided.
+func (s *Logger) EnabledCalls() []struct{ Level int } {
+	return s.enabledCalls
+}
+
+// EnabledCallCount returns the number of calls made to Enabled.
+func (s *Logger) EnabledCallCount() int {
+	return len(s.enabledCalls)
+}
+
+// EnabledCalled reports whether Enabled has been called.
+func (s *Logger) EnabledCalled() bool {
+	return s.EnabledCallCount() > 0
+}
+
+// EnabledLastCall returns the parameters of the most recent call to Enabled,
+// and whether there has been one.
+func (s *Logger) EnabledLastCall() (struct{ Level int }, bool) {
+	calls := s.EnabledCalls()
+	if len(calls) == 0 {
+		return struct{ Level int }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Printf delegates its behavior to the field PrintfStub.
+func (s *Logger) Printf(format string, args ...interface{}) {
+	if s.PrintfStub == nil {
+		panic("Logger.Printf: nil method stub")
+	}
+	s.printfCalls = append(s.printfCalls, struct {
+		Format string
+		Args   []interface{}
+	}{Format: format, Args: args})
+	(s.PrintfStub)(format, args...)
+}
+
+// PrintfCalls returns a slice of calls made to Printf. Each element
+// of the slice represents the parameters that were provided.
+func (s *Logger) PrintfCalls() []struct {
+	Format string
+	Args   []interface{}
+} {
+	return s.printfCalls
+}
+
+// PrintfCallCount returns the number of calls made to Printf.
+func (s *Logger) PrintfCallCount() int {
+	return len(s.printfCalls)
+}
+
+// PrintfCalled reports whether Printf has been called.
+func (s *Logger) PrintfCalled() bool {
+	return s.PrintfCallCount() > 0
+}
+
+// PrintfLastCall returns the parameters of the most recent call to Printf,
+// and whether there has been one.
+func (s *Logger) PrintfLastCall() (struct {
+	Format string
+	Args   []interface{}
+}, bool) {
+	calls := s.PrintfCalls()
+	if len(calls) == 0 {
+		return struct {
+			Format string
+			Args   []interface{}
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Println delegates its behavior to the field PrintlnStub.
+func (s *Logger) Println(args ...interface{}) {
+	if s.PrintlnStub == nil {
+		panic("Logger.Println: nil method stub")
+	}
+	s.printlnCalls = append(s.printlnCalls, struct{ Args []interface{} }{Args: args})
+	(s.PrintlnStub)(args...)
+}
+
+// PrintlnCalls returns a slice of calls made to Println. Each element
+// of the slice represents the parameters that were provided.
+func (s *Logger) PrintlnCalls() []struct{ Args []interface{} } {
+	return s.printlnCalls
+}
+
+// PrintlnCallCount returns the number of calls made to Println.
+func (s *Logger) PrintlnCallCount() int {
+	return len(s.printlnCalls)
+}
+
+// PrintlnCalled reports whether Println has been called.
+func (s *Logger) PrintlnCalled() bool {
+	return s.PrintlnCallCount() > 0
+}
+
+// PrintlnLastCall returns the parameters of the most recent call to Println,
+// and whether there has been one.
+func (s *Logger) PrintlnLastCall() (struct{ Args []interface{} }, bool) {
+	calls := s.PrintlnCalls()
+	if len(calls) == 0 {
+		return struct{ Args []interface{} }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Logger) Reset() {
+	s.enabledCalls = nil
+	s.printfCalls = nil
+	s.printlnCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ logger.Logger = (*Logger)(nil)
@@ -0,0 +1,146 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/lookup"
+	"github.com/dradtke/stubber/testdata/lookup/key"
+	"github.com/dradtke/stubber/testdata/lookup/value"
+)
+
+// Lookup is a stubbed implementation of lookup.Lookup.
+type Lookup struct {
+	// DescribeStub defines the implementation for Describe.
+	DescribeStub  func(entry struct{ Key key.K }) interface{ Get() value.V }
+	describeCalls []struct{ Entry struct{ Key key.K } }
+	// RecentStub defines the implementation for Recent.
+	RecentStub  func() [3]key.K
+	recentCalls []struct{}
+	// WatchStub defines the implementation for Watch.
+	WatchStub  func(updates <-chan value.V)
+	watchCalls []struct{ Updates <-chan value.V }
+}
+
+// NewLookup returns a new Lookup without any stubs set.
+func NewLookup() *Lookup {
+	return &Lookup{}
+}
+
+// Describe delegates its behavior to the field DescribeStub.
+func (s *Lookup) Describe(entry struct{ Key key.K }) interface{ Get() value.V } {
+	if s.DescribeStub == nil {
+		panic("Lookup.Describe: nil method stub")
+	}
+	s.describeCalls = append(s.describeCalls, struct{ Entry struct{ Key key.K } }{Entry: entry})
+	return (s.DescribeStub)(entry)
+}
+
+// DescribeCalls returns a slice of calls made to Describe. Each element
+// of the slice represents the parameters that were provided.
+func (s *Lookup) DescribeCalls() []struct{ Entry struct{ Key key.K } } {
+	return s.describeCalls
+}
+
+// DescribeCallCount returns the number of calls made to Describe.
+func (s *Lookup) DescribeCallCount() int {
+	return len(s.describeCalls)
+}
+
+// DescribeCalled reports whether Describe has been called.
+func (s *Lookup) DescribeCalled() bool {
+	return s.DescribeCallCount() > 0
+}
+
+// DescribeLastCall returns the parameters of the most recent call to Describe,
+// and whether there has been one.
+func (s *Lookup) DescribeLastCall() (struct{ Entry struct{ Key key.K } }, bool) {
+	calls := s.DescribeCalls()
+	if len(calls) == 0 {
+		return struct{ Entry struct{ Key key.K } }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Recent delegates its behavior to the field RecentStub.
+func (s *Lookup) Recent() [3]key.K {
+	if s.RecentStub == nil {
+		panic("Lookup.Recent: nil method stub")
+	}
+	s.recentCalls = append(s.recentCalls, struct{}{})
+	return (s.RecentStub)()
+}
+
+// RecentCalls returns a slice of calls made to Recent. Each element
+// of the slice represents the parameters that were provided.
+func (s *Lookup) RecentCalls() []struct{} {
+	return s.recentCalls
+}
+
+// RecentCallCount returns the number of calls made to Recent.
+func (s *Lookup) RecentCallCount() int {
+	return len(s.recentCalls)
+}
+
+// RecentCalled reports whether Recent has been called.
+func (s *Lookup) RecentCalled() bool {
+	return s.RecentCallCount() > 0
+}
+
+// RecentLastCall returns the parameters of the most recent call to Recent,
+// and whether there has been one.
+func (s *Lookup) RecentLastCall() (struct{}, bool) {
+	calls := s.RecentCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Watch delegates its behavior to the field WatchStub.
+func (s *Lookup) Watch(updates <-chan value.V) {
+	if s.WatchStub == nil {
+		panic("Lookup.Watch: nil method stub")
+	}
+	s.watchCalls = append(s.watchCalls, struct{ Updates <-chan value.V }{Updates: updates})
+	(s.WatchStub)(updates)
+}
+
+// WatchCalls returns a slice of calls made to Watch. Each element
+// of the slice represents the parameters that were provided.
+func (s *Lookup) WatchCalls() []struct{ Updates <-chan value.V } {
+	return s.watchCalls
+}
+
+// WatchCallCount returns the number of calls made to Watch.
+func (s *Lookup) WatchCallCount() int {
+	return len(s.watchCalls)
+}
+
+// WatchCalled reports whether Watch has been called.
+func (s *Lookup) WatchCalled() bool {
+	return s.WatchCallCount() > 0
+}
+
+// WatchLastCall returns the parameters of the most recent call to Watch,
+// and whether there has been one.
+func (s *Lookup) WatchLastCall() (struct{ Updates <-chan value.V }, bool) {
+	calls := s.WatchCalls()
+	if len(calls) == 0 {
+		return struct{ Updates <-chan value.V }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Lookup) Reset() {
+	s.describeCalls = nil
+	s.recentCalls = nil
+	s.watchCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ lookup.Lookup = (*Lookup)(nil)
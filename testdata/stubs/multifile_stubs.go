@@ -0,0 +1,298 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/multifile"
+	"io"
+	"time"
+)
+
+// Reader is a stubbed implementation of multifile.Reader.
+type Reader struct {
+	// OpenStub defines the implementation for Open.
+	OpenStub  func(key string) (io.ReadCloser, error)
+	openCalls []struct{ Key string }
+}
+
+// NewReader returns a new Reader without any stubs set.
+func NewReader() *Reader {
+	return &Reader{}
+}
+
+// Open delegates its behavior to the field OpenStub.
+func (s *Reader) Open(key string) (io.ReadCloser, error) {
+	if s.OpenStub == nil {
+		panic("Reader.Open: nil method stub")
+	}
+	s.openCalls = append(s.openCalls, struct{ Key string }{Key: key})
+	return (s.OpenStub)(key)
+}
+
+// OpenCalls returns a slice of calls made to Open. Each element
+// of the slice represents the parameters that were provided.
+func (s *Reader) OpenCalls() []struct{ Key string } {
+	return s.openCalls
+}
+
+// OpenCallCount returns the number of calls made to Open.
+func (s *Reader) OpenCallCount() int {
+	return len(s.openCalls)
+}
+
+// OpenCalled reports whether Open has been called.
+func (s *Reader) OpenCalled() bool {
+	return s.OpenCallCount() > 0
+}
+
+// OpenLastCall returns the parameters of the most recent call to Open,
+// and whether there has been one.
+func (s *Reader) OpenLastCall() (struct{ Key string }, bool) {
+	calls := s.OpenCalls()
+	if len(calls) == 0 {
+		return struct{ Key string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Reader) Reset() {
+	s.openCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ multifile.Reader = (*Reader)(nil)
+
+// Store is a stubbed implementation of multifile.Store.
+type Store struct {
+	// NameStub defines the implementation for Name.
+	NameStub  func() string
+	nameCalls []struct{}
+	// OpenStub defines the implementation for Open.
+	OpenStub  func(key string) (io.ReadCloser, error)
+	openCalls []struct{ Key string }
+	// PutStub defines the implementation for Put.
+	PutStub  func(key string, data []byte, expires time.Time) error
+	putCalls []struct {
+		Key     string
+		Data    []byte
+		Expires time.Time
+	}
+}
+
+// NewStore returns a new Store without any stubs set.
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Name delegates its behavior to the field NameStub.
+func (s *Store) Name() string {
+	if s.NameStub == nil {
+		panic("Store.Name: nil method stub")
+	}
+	s.nameCalls = append(s.nameCalls, struct{}{})
+	return (s.NameStub)()
+}
+
+// NameCalls returns a slice of calls made to Name. Each element
+// of the slice represents the parameters that were provided.
+func (s *Store) NameCalls() []struct{} {
+	return s.nameCalls
+}
+
+// NameCallCount returns the number of calls made to Name.
+func (s *Store) NameCallCount() int {
+	return len(s.nameCalls)
+}
+
+// NameCalled reports whether Name has been called.
+func (s *Store) NameCalled() bool {
+	return s.NameCallCount() > 0
+}
+
+// NameLastCall returns the parameters of the most recent call to Name,
+// and whether there has been one.
+func (s *Store) NameLastCall() (struct{}, bool) {
+	calls := s.NameCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Open delegates its behavior to the field OpenStub.
+func (s *Store) Open(key string) (io.ReadCloser, error) {
+	if s.OpenStub == nil {
+		panic("Store.Open: nil method stub")
+	}
+	s.openCalls = append(s.openCalls, struct{ Key string }{Key: key})
+	return (s.OpenStub)(key)
+}
+
+// OpenCalls returns a slice of calls made to Open. Each element
+// of the slice represents the parameters that were provided.
+func (s *Store) OpenCalls() []struct{ Key string } {
+	return s.openCalls
+}
+
+// OpenCallCount returns the number of calls made to Open.
+func (s *Store) OpenCallCount() int {
+	return len(s.openCalls)
+}
+
+// OpenCalled reports whether Open has been called.
+func (s *Store) OpenCalled() bool {
+	return s.OpenCallCount() > 0
+}
+
+// OpenLastCall returns the parameters of the most recent call to Open,
+// and whether there has been one.
+func (s *Store) OpenLastCall() (struct{ Key string }, bool) {
+	calls := s.OpenCalls()
+	if len(calls) == 0 {
+		return struct{ Key string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Put delegates its behavior to the field PutStub.
+func (s *Store) Put(key string, data []byte, expires time.Time) error {
+	if s.PutStub == nil {
+		panic("Store.Put: nil method stub")
+	}
+	s.putCalls = append(s.putCalls, struct {
+		Key     string
+		Data    []byte
+		Expires time.Time
+	}{Key: key, Data: data, Expires: expires})
+	return (s.PutStub)(key, data, expires)
+}
+
+// PutCalls returns a slice of calls made to Put. Each element
+// of the slice represents the parameters that were provided.
+func (s *Store) PutCalls() []struct {
+	Key     string
+	Data    []byte
+	Expires time.Time
+} {
+	return s.putCalls
+}
+
+// PutCallCount returns the number of calls made to Put.
+func (s *Store) PutCallCount() int {
+	return len(s.putCalls)
+}
+
+// PutCalled reports whether Put has been called.
+func (s *Store) PutCalled() bool {
+	return s.PutCallCount() > 0
+}
+
+// PutLastCall returns the parameters of the most recent call to Put,
+// and whether there has been one.
+func (s *Store) PutLastCall() (struct {
+	Key     string
+	Data    []byte
+	Expires time.Time
+}, bool) {
+	calls := s.PutCalls()
+	if len(calls) == 0 {
+		return struct {
+			Key     string
+			Data    []byte
+			Expires time.Time
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Store) Reset() {
+	s.nameCalls = nil
+	s.openCalls = nil
+	s.putCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ multifile.Store = (*Store)(nil)
+
+// Writer is a stubbed implementation of multifile.Writer.
+type Writer struct {
+	// PutStub defines the implementation for Put.
+	PutStub  func(key string, data []byte, expires time.Time) error
+	putCalls []struct {
+		Key     string
+		Data    []byte
+		Expires time.Time
+	}
+}
+
+// NewWriter returns a new Writer without any stubs set.
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Put delegates its behavior to the field PutStub.
+func (s *Writer) Put(key string, data []byte, expires time.Time) error {
+	if s.PutStub == nil {
+		panic("Writer.Put: nil method stub")
+	}
+	s.putCalls = append(s.putCalls, struct {
+		Key     string
+		Data    []byte
+		Expires time.Time
+	}{Key: key, Data: data, Expires: expires})
+	return (s.PutStub)(key, data, expires)
+}
+
+// PutCalls returns a slice of calls made to Put. Each element
+// of the slice represents the parameters that were provided.
+func (s *Writer) PutCalls() []struct {
+	Key     string
+	Data    []byte
+	Expires time.Time
+} {
+	return s.putCalls
+}
+
+// PutCallCount returns the number of calls made to Put.
+func (s *Writer) PutCallCount() int {
+	return len(s.putCalls)
+}
+
+// PutCalled reports whether Put has been called.
+func (s *Writer) PutCalled() bool {
+	return s.PutCallCount() > 0
+}
+
+// PutLastCall returns the parameters of the most recent call to Put,
+// and whether there has been one.
+func (s *Writer) PutLastCall() (struct {
+	Key     string
+	Data    []byte
+	Expires time.Time
+}, bool) {
+	calls := s.PutCalls()
+	if len(calls) == 0 {
+		return struct {
+			Key     string
+			Data    []byte
+			Expires time.Time
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Writer) Reset() {
+	s.putCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ multifile.Writer = (*Writer)(nil)
@@ -0,0 +1,161 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/repo"
+)
+
+// Repo is a stubbed implementation of repo.Repo.
+type Repo[K comparable, V any] struct {
+	// GetStub defines the implementation for Get.
+	GetStub  func(key K) (V, bool)
+	getCalls []struct{ Key K }
+	// KeysStub defines the implementation for Keys.
+	KeysStub  func() []K
+	keysCalls []struct{}
+	// PutStub defines the implementation for Put.
+	PutStub  func(key K, value V) error
+	putCalls []struct {
+		Key   K
+		Value V
+	}
+}
+
+// NewRepo returns a new Repo without any stubs set.
+func NewRepo[K comparable, V any]() *Repo[K, V] {
+	return &Repo[K, V]{}
+}
+
+// Get delegates its behavior to the field GetStub.
+func (s *Repo[K, V]) Get(key K) (V, bool) {
+	if s.GetStub == nil {
+		panic("Repo.Get: nil method stub")
+	}
+	s.getCalls = append(s.getCalls, struct{ Key K }{Key: key})
+	return (s.GetStub)(key)
+}
+
+// GetCalls returns a slice of calls made to Get. Each element
+// of the slice represents the parameters that were provided.
+func (s *Repo[K, V]) GetCalls() []struct{ Key K } {
+	return s.getCalls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (s *Repo[K, V]) GetCallCount() int {
+	return len(s.getCalls)
+}
+
+// GetCalled reports whether Get has been called.
+func (s *Repo[K, V]) GetCalled() bool {
+	return s.GetCallCount() > 0
+}
+
+// GetLastCall returns the parameters of the most recent call to Get,
+// and whether there has been one.
+func (s *Repo[K, V]) GetLastCall() (struct{ Key K }, bool) {
+	calls := s.GetCalls()
+	if len(calls) == 0 {
+		return struct{ Key K }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Keys delegates its behavior to the field KeysStub.
+func (s *Repo[K, V]) Keys() []K {
+	if s.KeysStub == nil {
+		panic("Repo.Keys: nil method stub")
+	}
+	s.keysCalls = append(s.keysCalls, struct{}{})
+	return (s.KeysStub)()
+}
+
+// KeysCalls returns a slice of calls made to Keys. Each element
+// of the slice represents the parameters that were provided.
+func (s *Repo[K, V]) KeysCalls() []struct{} {
+	return s.keysCalls
+}
+
+// KeysCallCount returns the number of calls made to Keys.
+func (s *Repo[K, V]) KeysCallCount() int {
+	return len(s.keysCalls)
+}
+
+// KeysCalled reports whether Keys has been called.
+func (s *Repo[K, V]) KeysCalled() bool {
+	return s.KeysCallCount() > 0
+}
+
+// KeysLastCall returns the parameters of the most recent call to Keys,
+// and whether there has been one.
+func (s *Repo[K, V]) KeysLastCall() (struct{}, bool) {
+	calls := s.KeysCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Put delegates its behavior to the field PutStub.
+func (s *Repo[K, V]) Put(key K, value V) error {
+	if s.PutStub == nil {
+		panic("Repo.Put: nil method stub")
+	}
+	s.putCalls = append(s.putCalls, struct {
+		Key   K
+		Value V
+	}{Key: key, Value: value})
+	return (s.PutStub)(key, value)
+}
+
+// PutCalls returns a slice of calls made to Put. Each element
+// of the slice represents the parameters that were provided.
+func (s *Repo[K, V]) PutCalls() []struct {
+	Key   K
+	Value V
+} {
+	return s.putCalls
+}
+
+// PutCallCount returns the number of calls made to Put.
+func (s *Repo[K, V]) PutCallCount() int {
+	return len(s.putCalls)
+}
+
+// PutCalled reports whether Put has been called.
+func (s *Repo[K, V]) PutCalled() bool {
+	return s.PutCallCount() > 0
+}
+
+// PutLastCall returns the parameters of the most recent call to Put,
+// and whether there has been one.
+func (s *Repo[K, V]) PutLastCall() (struct {
+	Key   K
+	Value V
+}, bool) {
+	calls := s.PutCalls()
+	if len(calls) == 0 {
+		return struct {
+			Key   K
+			Value V
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Repo[K, V]) Reset() {
+	s.getCalls = nil
+	s.keysCalls = nil
+	s.putCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+func _[K comparable, V any]() {
+	var _ repo.Repo[K, V] = (*Repo[K, V])(nil)
+}
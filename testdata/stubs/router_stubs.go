@@ -0,0 +1,116 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/router"
+	"net/http"
+)
+
+// Router is a stubbed implementation of router.Router.
+type Router struct {
+	// HandlersStub defines the implementation for Handlers.
+	HandlersStub  func() map[string]func(http.ResponseWriter, *http.Request)
+	handlersCalls []struct{}
+	// UseStub defines the implementation for Use.
+	UseStub  func(middleware ...func(http.Handler) http.Handler)
+	useCalls []struct {
+		Middleware []func(http.Handler) http.Handler
+	}
+}
+
+// NewRouter returns a new Router without any stubs set.
+func NewRouter() *Router {
+	return &Router{}
+}
+
+// Handlers delegates its behavior to the field HandlersStub.
+func (s *Router) Handlers() map[string]func(http.ResponseWriter, *http.Request) {
+	if s.HandlersStub == nil {
+		panic("Router.Handlers: nil method stub")
+	}
+	s.handlersCalls = append(s.handlersCalls, struct{}{})
+	return (s.HandlersStub)()
+}
+
+// HandlersCalls returns a slice of calls made to Handlers. Each element
+// of the slice represents the parameters that were provided.
+func (s *Router) HandlersCalls() []struct{} {
+	return s.handlersCalls
+}
+
+// HandlersCallCount returns the number of calls made to Handlers.
+func (s *Router) HandlersCallCount() int {
+	return len(s.handlersCalls)
+}
+
+// HandlersCalled reports whether Handlers has been called.
+func (s *Router) HandlersCalled() bool {
+	return s.HandlersCallCount() > 0
+}
+
+// HandlersLastCall returns the parameters of the most recent call to Handlers,
+// and whether there has been one.
+func (s *Router) HandlersLastCall() (struct{}, bool) {
+	calls := s.HandlersCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Use delegates its behavior to the field UseStub.
+func (s *Router) Use(middleware ...func(http.Handler) http.Handler) {
+	if s.UseStub == nil {
+		panic("Router.Use: nil method stub")
+	}
+	s.useCalls = append(s.useCalls, struct {
+		Middleware []func(http.Handler) http.Handler
+	}{Middleware: middleware})
+	(s.UseStub)(middleware...)
+}
+
+// UseCalls returns a slice of calls made to Use. Each element
+// of the slice represents the parameters that were provided.
+func (s *Router) UseCalls() []struct {
+	Middleware []func(http.Handler) http.Handler
+} {
+	return s.useCalls
+}
+
+// UseCallCount returns the number of calls made to Use.
+func (s *Router) UseCallCount() int {
+	return len(s.useCalls)
+}
+
+// UseCalled reports whether Use has been called.
+func (s *Router) UseCalled() bool {
+	return s.UseCallCount() > 0
+}
+
+// UseLastCall returns the parameters of the most recent call to Use,
+// and whether there has been one.
+func (s *Router) UseLastCall() (struct {
+	Middleware []func(http.Handler) http.Handler
+}, bool) {
+	calls := s.UseCalls()
+	if len(calls) == 0 {
+		return struct {
+			Middleware []func(http.Handler) http.Handler
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Router) Reset() {
+	s.handlersCalls = nil
+	s.useCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ router.Router = (*Router)(nil)
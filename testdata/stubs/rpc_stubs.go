@@ -0,0 +1,87 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"context"
+	"github.com/dradtke/stubber/testdata/rpc"
+)
+
+// Client is a stubbed implementation of rpc.Client.
+type Client struct {
+	// DoStub defines the implementation for Do.
+	DoStub  func(ctx context.Context, req *rpc.Request, opts ...rpc.Option) (*rpc.Response, error)
+	doCalls []struct {
+		Ctx  context.Context
+		Req  *rpc.Request
+		Opts []rpc.Option
+	}
+}
+
+// NewClient returns a new Client without any stubs set.
+func NewClient() *Client {
+	return &Client{}
+}
+
+// Do delegates its behavior to the field DoStub.
+func (s *Client) Do(ctx context.Context, req *rpc.Request, opts ...rpc.Option) (*rpc.Response, error) {
+	if s.DoStub == nil {
+		panic("Client.Do: nil method stub")
+	}
+	s.doCalls = append(s.doCalls, struct {
+		Ctx  context.Context
+		Req  *rpc.Request
+		Opts []rpc.Option
+	}{Ctx: ctx, Req: req, Opts: opts})
+	return (s.DoStub)(ctx, req, opts...)
+}
+
+// DoCalls returns a slice of calls made to Do. Each element
+// of the slice represents the parameters that were provided.
+func (s *Client) DoCalls() []struct {
+	Ctx  context.Context
+	Req  *rpc.Request
+	Opts []rpc.Option
+} {
+	return s.doCalls
+}
+
+// DoCallCount returns the number of calls made to Do.
+func (s *Client) DoCallCount() int {
+	return len(s.doCalls)
+}
+
+// DoCalled reports whether Do has been called.
+func (s *Client) DoCalled() bool {
+	return s.DoCallCount() > 0
+}
+
+// DoLastCall returns the parameters of the most recent call to Do,
+// and whether there has been one.
+func (s *Client) DoLastCall() (struct {
+	Ctx  context.Context
+	Req  *rpc.Request
+	Opts []rpc.Option
+}, bool) {
+	calls := s.DoCalls()
+	if len(calls) == 0 {
+		return struct {
+			Ctx  context.Context
+			Req  *rpc.Request
+			Opts []rpc.Option
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Client) Reset() {
+	s.doCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ rpc.Client = (*Client)(nil)
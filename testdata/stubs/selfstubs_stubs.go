@@ -0,0 +1,66 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/selfstubs"
+)
+
+// Cache is a stubbed implementation of selfstubs.Cache.
+type Cache struct {
+	// GetStub defines the implementation for Get.
+	GetStub  func(key string) (string, error)
+	getCalls []struct{ Key string }
+}
+
+// NewCache returns a new Cache without any stubs set.
+func NewCache() *Cache {
+	return &Cache{}
+}
+
+// Get delegates its behavior to the field GetStub.
+func (s *Cache) Get(key string) (string, error) {
+	if s.GetStub == nil {
+		panic("Cache.Get: nil method stub")
+	}
+	s.getCalls = append(s.getCalls, struct{ Key string }{Key: key})
+	return (s.GetStub)(key)
+}
+
+// GetCalls returns a slice of calls made to Get. Each element
+// of the slice represents the parameters that were provided.
+func (s *Cache) GetCalls() []struct{ Key string } {
+	return s.getCalls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (s *Cache) GetCallCount() int {
+	return len(s.getCalls)
+}
+
+// GetCalled reports whether Get has been called.
+func (s *Cache) GetCalled() bool {
+	return s.GetCallCount() > 0
+}
+
+// GetLastCall returns the parameters of the most recent call to Get,
+// and whether there has been one.
+func (s *Cache) GetLastCall() (struct{ Key string }, bool) {
+	calls := s.GetCalls()
+	if len(calls) == 0 {
+		return struct{ Key string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Cache) Reset() {
+	s.getCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ selfstubs.Cache = (*Cache)(nil)
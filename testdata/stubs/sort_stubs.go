@@ -0,0 +1,196 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"sort"
+)
+
+// Interface is a stubbed implementation of sort.Interface.
+type Interface struct {
+	// LenStub defines the implementation for Len.
+	LenStub  func() int
+	lenCalls []struct{}
+	// LessStub defines the implementation for Less.
+	LessStub  func(i int, j int) bool
+	lessCalls []struct {
+		I int
+		J int
+	}
+	// SwapStub defines the implementation for Swap.
+	SwapStub  func(i int, j int)
+	swapCalls []struct {
+		I int
+		J int
+	}
+}
+
+// NewInterface returns a new Interface without any stubs set.
+func NewInterface() *Interface {
+	return &Interface{}
+}
+
+// Len is the number of elements in the collection.
+//
+// Len delegates its behavior to the field LenStub.
+func (s *Interface) Len() int {
+	if s.LenStub == nil {
+		panic("Interface.Len: nil method stub")
+	}
+	s.lenCalls = append(s.lenCalls, struct{}{})
+	return (s.LenStub)()
+}
+
+// LenCalls returns a slice of calls made to Len. Each element
+// of the slice represents the parameters that were provided.
+func (s *Interface) LenCalls() []struct{} {
+	return s.lenCalls
+}
+
+// LenCallCount returns the number of calls made to Len.
+func (s *Interface) LenCallCount() int {
+	return len(s.lenCalls)
+}
+
+// LenCalled reports whether Len has been called.
+func (s *Interface) LenCalled() bool {
+	return s.LenCallCount() > 0
+}
+
+// LenLastCall returns the parameters of the most recent call to Len,
+// and whether there has been one.
+func (s *Interface) LenLastCall() (struct{}, bool) {
+	calls := s.LenCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Less reports whether the element with index i
+// must sort before the element with index j.
+//
+// If both Less(i, j) and Less(j, i) are false,
+// then the elements at index i and j are considered equal.
+// Sort may place equal elements in any order in the final result,
+// while Stable preserves the original input order of equal elements.
+//
+// Less must describe a [Strict Weak Ordering]. For example:
+//   - if both Less(i, j) and Less(j, k) are true, then Less(i, k) must be true as well.
+//   - if both Less(i, j) and Less(j, k) are false, then Less(i, k) must be false as well.
+//
+// Note that floating-point comparison (the < operator on float32 or float64 values)
+// is not a strict weak ordering when not-a-number (NaN) values are involved.
+// See Float64Slice.Less for a correct implementation for floating-point values.
+//
+// Less delegates its behavior to the field LessStub.
+//
+// [Strict Weak Ordering]: https://en.wikipedia.org/wiki/Weak_ordering#Strict_weak_orderings
+func (s *Interface) Less(i int, j int) bool {
+	if s.LessStub == nil {
+		panic("Interface.Less: nil method stub")
+	}
+	s.lessCalls = append(s.lessCalls, struct {
+		I int
+		J int
+	}{I: i, J: j})
+	return (s.LessStub)(i, j)
+}
+
+// LessCalls returns a slice of calls made to Less. Each element
+// of the slice represents the parameters that were provided.
+func (s *Interface) LessCalls() []struct {
+	I int
+	J int
+} {
+	return s.lessCalls
+}
+
+// LessCallCount returns the number of calls made to Less.
+func (s *Interface) LessCallCount() int {
+	return len(s.lessCalls)
+}
+
+// LessCalled reports whether Less has been called.
+func (s *Interface) LessCalled() bool {
+	return s.LessCallCount() > 0
+}
+
+// LessLastCall returns the parameters of the most recent call to Less,
+// and whether there has been one.
+func (s *Interface) LessLastCall() (struct {
+	I int
+	J int
+}, bool) {
+	calls := s.LessCalls()
+	if len(calls) == 0 {
+		return struct {
+			I int
+			J int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Swap swaps the elements with indexes i and j.
+//
+// Swap delegates its behavior to the field SwapStub.
+func (s *Interface) Swap(i int, j int) {
+	if s.SwapStub == nil {
+		panic("Interface.Swap: nil method stub")
+	}
+	s.swapCalls = append(s.swapCalls, struct {
+		I int
+		J int
+	}{I: i, J: j})
+	(s.SwapStub)(i, j)
+}
+
+// SwapCalls returns a slice of calls made to Swap. Each element
+// of the slice represents the parameters that were provided.
+func (s *Interface) SwapCalls() []struct {
+	I int
+	J int
+} {
+	return s.swapCalls
+}
+
+// SwapCallCount returns the number of calls made to Swap.
+func (s *Interface) SwapCallCount() int {
+	return len(s.swapCalls)
+}
+
+// SwapCalled reports whether Swap has been called.
+func (s *Interface) SwapCalled() bool {
+	return s.SwapCallCount() > 0
+}
+
+// SwapLastCall returns the parameters of the most recent call to Swap,
+// and whether there has been one.
+func (s *Interface) SwapLastCall() (struct {
+	I int
+	J int
+}, bool) {
+	calls := s.SwapCalls()
+	if len(calls) == 0 {
+		return struct {
+			I int
+			J int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Interface) Reset() {
+	s.lenCalls = nil
+	s.lessCalls = nil
+	s.swapCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ sort.Interface = (*Interface)(nil)
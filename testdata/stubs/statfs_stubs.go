@@ -0,0 +1,146 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/statfs"
+	"os"
+	"unsafe"
+)
+
+// Statter is a stubbed implementation of statfs.Statter.
+type Statter struct {
+	// AddrStub defines the implementation for Addr.
+	AddrStub  func() unsafe.Pointer
+	addrCalls []struct{}
+	// StatStub defines the implementation for Stat.
+	StatStub  func(name string) (os.FileInfo, error)
+	statCalls []struct{ Name string }
+	// SysStub defines the implementation for Sys.
+	SysStub  func(v any) any
+	sysCalls []struct{ V any }
+}
+
+// NewStatter returns a new Statter without any stubs set.
+func NewStatter() *Statter {
+	return &Statter{}
+}
+
+// Addr delegates its behavior to the field AddrStub.
+func (s *Statter) Addr() unsafe.Pointer {
+	if s.AddrStub == nil {
+		panic("Statter.Addr: nil method stub")
+	}
+	s.addrCalls = append(s.addrCalls, struct{}{})
+	return (s.AddrStub)()
+}
+
+// AddrCalls returns a slice of calls made to Addr. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statter) AddrCalls() []struct{} {
+	return s.addrCalls
+}
+
+// AddrCallCount returns the number of calls made to Addr.
+func (s *Statter) AddrCallCount() int {
+	return len(s.addrCalls)
+}
+
+// AddrCalled reports whether Addr has been called.
+func (s *Statter) AddrCalled() bool {
+	return s.AddrCallCount() > 0
+}
+
+// AddrLastCall returns the parameters of the most recent call to Addr,
+// and whether there has been one.
+func (s *Statter) AddrLastCall() (struct{}, bool) {
+	calls := s.AddrCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Stat delegates its behavior to the field StatStub.
+func (s *Statter) Stat(name string) (os.FileInfo, error) {
+	if s.StatStub == nil {
+		panic("Statter.Stat: nil method stub")
+	}
+	s.statCalls = append(s.statCalls, struct{ Name string }{Name: name})
+	return (s.StatStub)(name)
+}
+
+// StatCalls returns a slice of calls made to Stat. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statter) StatCalls() []struct{ Name string } {
+	return s.statCalls
+}
+
+// StatCallCount returns the number of calls made to Stat.
+func (s *Statter) StatCallCount() int {
+	return len(s.statCalls)
+}
+
+// StatCalled reports whether Stat has been called.
+func (s *Statter) StatCalled() bool {
+	return s.StatCallCount() > 0
+}
+
+// StatLastCall returns the parameters of the most recent call to Stat,
+// and whether there has been one.
+func (s *Statter) StatLastCall() (struct{ Name string }, bool) {
+	calls := s.StatCalls()
+	if len(calls) == 0 {
+		return struct{ Name string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Sys delegates its behavior to the field SysStub.
+func (s *Statter) Sys(v any) any {
+	if s.SysStub == nil {
+		panic("Statter.Sys: nil method stub")
+	}
+	s.sysCalls = append(s.sysCalls, struct{ V any }{V: v})
+	return (s.SysStub)(v)
+}
+
+// SysCalls returns a slice of calls made to Sys. Each element
+// of the slice represents the parameters that were provided.
+func (s *Statter) SysCalls() []struct{ V any } {
+	return s.sysCalls
+}
+
+// SysCallCount returns the number of calls made to Sys.
+func (s *Statter) SysCallCount() int {
+	return len(s.sysCalls)
+}
+
+// SysCalled reports whether Sys has been called.
+func (s *Statter) SysCalled() bool {
+	return s.SysCallCount() > 0
+}
+
+// SysLastCall returns the parameters of the most recent call to Sys,
+// and whether there has been one.
+func (s *Statter) SysLastCall() (struct{ V any }, bool) {
+	calls := s.SysCalls()
+	if len(calls) == 0 {
+		return struct{ V any }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Statter) Reset() {
+	s.addrCalls = nil
+	s.statCalls = nil
+	s.sysCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ statfs.Statter = (*Statter)(nil)
@@ -0,0 +1,223 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/stream"
+	"io"
+)
+
+// Stream is a stubbed implementation of stream.Stream.
+type Stream struct {
+	// CloseStub defines the implementation for Close.
+	CloseStub  func() error
+	closeCalls []struct{}
+	// FlushStub defines the implementation for Flush.
+	FlushStub  func() error
+	flushCalls []struct{}
+	// ReadStub defines the implementation for Read.
+	ReadStub  func(p []byte) (int, error)
+	readCalls []struct{ P []byte }
+	// WriteStub defines the implementation for Write.
+	WriteStub  func(p []byte) (int, error)
+	writeCalls []struct{ P []byte }
+	// WriteToStub defines the implementation for WriteTo.
+	WriteToStub  func(w io.Writer) (int64, error)
+	writeToCalls []struct{ W io.Writer }
+}
+
+// NewStream returns a new Stream without any stubs set.
+func NewStream() *Stream {
+	return &Stream{}
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s *Stream) Close() error {
+	if s.CloseStub == nil {
+		panic("Stream.Close: nil method stub")
+	}
+	s.closeCalls = append(s.closeCalls, struct{}{})
+	return (s.CloseStub)()
+}
+
+// CloseCalls returns a slice of calls made to Close. Each element
+// of the slice represents the parameters that were provided.
+func (s *Stream) CloseCalls() []struct{} {
+	return s.closeCalls
+}
+
+// CloseCallCount returns the number of calls made to Close.
+func (s *Stream) CloseCallCount() int {
+	return len(s.closeCalls)
+}
+
+// CloseCalled reports whether Close has been called.
+func (s *Stream) CloseCalled() bool {
+	return s.CloseCallCount() > 0
+}
+
+// CloseLastCall returns the parameters of the most recent call to Close,
+// and whether there has been one.
+func (s *Stream) CloseLastCall() (struct{}, bool) {
+	calls := s.CloseCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Flush delegates its behavior to the field FlushStub.
+func (s *Stream) Flush() error {
+	if s.FlushStub == nil {
+		panic("Stream.Flush: nil method stub")
+	}
+	s.flushCalls = append(s.flushCalls, struct{}{})
+	return (s.FlushStub)()
+}
+
+// FlushCalls returns a slice of calls made to Flush. Each element
+// of the slice represents the parameters that were provided.
+func (s *Stream) FlushCalls() []struct{} {
+	return s.flushCalls
+}
+
+// FlushCallCount returns the number of calls made to Flush.
+func (s *Stream) FlushCallCount() int {
+	return len(s.flushCalls)
+}
+
+// FlushCalled reports whether Flush has been called.
+func (s *Stream) FlushCalled() bool {
+	return s.FlushCallCount() > 0
+}
+
+// FlushLastCall returns the parameters of the most recent call to Flush,
+// and whether there has been one.
+func (s *Stream) FlushLastCall() (struct{}, bool) {
+	calls := s.FlushCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Read delegates its behavior to the field ReadStub.
+func (s *Stream) Read(p []byte) (int, error) {
+	if s.ReadStub == nil {
+		panic("Stream.Read: nil method stub")
+	}
+	s.readCalls = append(s.readCalls, struct{ P []byte }{P: p})
+	return (s.ReadStub)(p)
+}
+
+// ReadCalls returns a slice of calls made to Read. Each element
+// of the slice represents the parameters that were provided.
+func (s *Stream) ReadCalls() []struct{ P []byte } {
+	return s.readCalls
+}
+
+// ReadCallCount returns the number of calls made to Read.
+func (s *Stream) ReadCallCount() int {
+	return len(s.readCalls)
+}
+
+// ReadCalled reports whether Read has been called.
+func (s *Stream) ReadCalled() bool {
+	return s.ReadCallCount() > 0
+}
+
+// ReadLastCall returns the parameters of the most recent call to Read,
+// and whether there has been one.
+func (s *Stream) ReadLastCall() (struct{ P []byte }, bool) {
+	calls := s.ReadCalls()
+	if len(calls) == 0 {
+		return struct{ P []byte }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Write delegates its behavior to the field WriteStub.
+func (s *Stream) Write(p []byte) (int, error) {
+	if s.WriteStub == nil {
+		panic("Stream.Write: nil method stub")
+	}
+	s.writeCalls = append(s.writeCalls, struct{ P []byte }{P: p})
+	return (s.WriteStub)(p)
+}
+
+// WriteCalls returns a slice of calls made to Write. Each element
+// of the slice represents the parameters that were provided.
+func (s *Stream) WriteCalls() []struct{ P []byte } {
+	return s.writeCalls
+}
+
+// WriteCallCount returns the number of calls made to Write.
+func (s *Stream) WriteCallCount() int {
+	return len(s.writeCalls)
+}
+
+// WriteCalled reports whether Write has been called.
+func (s *Stream) WriteCalled() bool {
+	return s.WriteCallCount() > 0
+}
+
+// WriteLastCall returns the parameters of the most recent call to Write,
+// and whether there has been one.
+func (s *Stream) WriteLastCall() (struct{ P []byte }, bool) {
+	calls := s.WriteCalls()
+	if len(calls) == 0 {
+		return struct{ P []byte }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// WriteTo delegates its behavior to the field WriteToStub.
+func (s *Stream) WriteTo(w io.Writer) (int64, error) {
+	if s.WriteToStub == nil {
+		panic("Stream.WriteTo: nil method stub")
+	}
+	s.writeToCalls = append(s.writeToCalls, struct{ W io.Writer }{W: w})
+	return (s.WriteToStub)(w)
+}
+
+// WriteToCalls returns a slice of calls made to WriteTo. Each element
+// of the slice represents the parameters that were provided.
+func (s *Stream) WriteToCalls() []struct{ W io.Writer } {
+	return s.writeToCalls
+}
+
+// WriteToCallCount returns the number of calls made to WriteTo.
+func (s *Stream) WriteToCallCount() int {
+	return len(s.writeToCalls)
+}
+
+// WriteToCalled reports whether WriteTo has been called.
+func (s *Stream) WriteToCalled() bool {
+	return s.WriteToCallCount() > 0
+}
+
+// WriteToLastCall returns the parameters of the most recent call to WriteTo,
+// and whether there has been one.
+func (s *Stream) WriteToLastCall() (struct{ W io.Writer }, bool) {
+	calls := s.WriteToCalls()
+	if len(calls) == 0 {
+		return struct{ W io.Writer }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Stream) Reset() {
+	s.closeCalls = nil
+	s.flushCalls = nil
+	s.readCalls = nil
+	s.writeCalls = nil
+	s.writeToCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ stream.Stream = (*Stream)(nil)
@@ -0,0 +1,174 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/tree"
+)
+
+// Tree is a stubbed implementation of tree.Tree.
+type Tree struct {
+	// FindStub defines the implementation for Find.
+	FindStub  func(from *tree.TreeNode, key string) (*tree.TreeNode, bool)
+	findCalls []struct {
+		From *tree.TreeNode
+		Key  string
+	}
+	// InsertStub defines the implementation for Insert.
+	InsertStub  func(parent *tree.TreeNode, node tree.TreeNode) *tree.TreeNode
+	insertCalls []struct {
+		Parent *tree.TreeNode
+		Node   tree.TreeNode
+	}
+	// RootStub defines the implementation for Root.
+	RootStub  func() *tree.TreeNode
+	rootCalls []struct{}
+}
+
+// NewTree returns a new Tree without any stubs set.
+func NewTree() *Tree {
+	return &Tree{}
+}
+
+// Find delegates its behavior to the field FindStub.
+func (s *Tree) Find(from *tree.TreeNode, key string) (*tree.TreeNode, bool) {
+	if s.FindStub == nil {
+		panic("Tree.Find: nil method stub")
+	}
+	s.findCalls = append(s.findCalls, struct {
+		From *tree.TreeNode
+		Key  string
+	}{From: from, Key: key})
+	return (s.FindStub)(from, key)
+}
+
+// FindCalls returns a slice of calls made to Find. Each element
+// of the slice represents the parameters that were provided.
+func (s *Tree) FindCalls() []struct {
+	From *tree.TreeNode
+	Key  string
+} {
+	return s.findCalls
+}
+
+// FindCallCount returns the number of calls made to Find.
+func (s *Tree) FindCallCount() int {
+	return len(s.findCalls)
+}
+
+// FindCalled reports whether Find has been called.
+func (s *Tree) FindCalled() bool {
+	return s.FindCallCount() > 0
+}
+
+// FindLastCall returns the parameters of the most recent call to Find,
+// and whether there has been one.
+func (s *Tree) FindLastCall() (struct {
+	From *tree.TreeNode
+	Key  string
+}, bool) {
+	calls := s.FindCalls()
+	if len(calls) == 0 {
+		return struct {
+			From *tree.TreeNode
+			Key  string
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Insert delegates its behavior to the field InsertStub.
+func (s *Tree) Insert(parent *tree.TreeNode, node tree.TreeNode) *tree.TreeNode {
+	if s.InsertStub == nil {
+		panic("Tree.Insert: nil method stub")
+	}
+	s.insertCalls = append(s.insertCalls, struct {
+		Parent *tree.TreeNode
+		Node   tree.TreeNode
+	}{Parent: parent, Node: node})
+	return (s.InsertStub)(parent, node)
+}
+
+// InsertCalls returns a slice of calls made to Insert. Each element
+// of the slice represents the parameters that were provided.
+func (s *Tree) InsertCalls() []struct {
+	Parent *tree.TreeNode
+	Node   tree.TreeNode
+} {
+	return s.insertCalls
+}
+
+// InsertCallCount returns the number of calls made to Insert.
+func (s *Tree) InsertCallCount() int {
+	return len(s.insertCalls)
+}
+
+// InsertCalled reports whether Insert has been called.
+func (s *Tree) InsertCalled() bool {
+	return s.InsertCallCount() > 0
+}
+
+// InsertLastCall returns the parameters of the most recent call to Insert,
+// and whether there has been one.
+func (s *Tree) InsertLastCall() (struct {
+	Parent *tree.TreeNode
+	Node   tree.TreeNode
+}, bool) {
+	calls := s.InsertCalls()
+	if len(calls) == 0 {
+		return struct {
+			Parent *tree.TreeNode
+			Node   tree.TreeNode
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Root delegates its behavior to the field RootStub.
+func (s *Tree) Root() *tree.TreeNode {
+	if s.RootStub == nil {
+		panic("Tree.Root: nil method stub")
+	}
+	s.rootCalls = append(s.rootCalls, struct{}{})
+	return (s.RootStub)()
+}
+
+// RootCalls returns a slice of calls made to Root. Each element
+// of the slice represents the parameters that were provided.
+func (s *Tree) RootCalls() []struct{} {
+	return s.rootCalls
+}
+
+// RootCallCount returns the number of calls made to Root.
+func (s *Tree) RootCallCount() int {
+	return len(s.rootCalls)
+}
+
+// RootCalled reports whether Root has been called.
+func (s *Tree) RootCalled() bool {
+	return s.RootCallCount() > 0
+}
+
+// RootLastCall returns the parameters of the most recent call to Root,
+// and whether there has been one.
+func (s *Tree) RootLastCall() (struct{}, bool) {
+	calls := s.RootCalls()
+	if len(calls) == 0 {
+		return struct{}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Tree) Reset() {
+	s.findCalls = nil
+	s.insertCalls = nil
+	s.rootCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ tree.Tree = (*Tree)(nil)
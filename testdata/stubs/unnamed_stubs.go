@@ -0,0 +1,135 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/unnamed"
+)
+
+// Decoder is a stubbed implementation of unnamed.Decoder.
+type Decoder struct {
+	// DecodeStub defines the implementation for Decode.
+	DecodeStub  func(arg0 []byte, arg1 interface{}) error
+	decodeCalls []struct {
+		Arg0 []byte
+		Arg1 interface{}
+	}
+	// SkipStub defines the implementation for Skip.
+	SkipStub  func(arg0 int, n int) int
+	skipCalls []struct {
+		Arg0 int
+		N    int
+	}
+}
+
+// NewDecoder returns a new Decoder without any stubs set.
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Decode delegates its behavior to the field DecodeStub.
+func (s *Decoder) Decode(arg0 []byte, arg1 interface{}) error {
+	if s.DecodeStub == nil {
+		panic("Decoder.Decode: nil method stub")
+	}
+	s.decodeCalls = append(s.decodeCalls, struct {
+		Arg0 []byte
+		Arg1 interface{}
+	}{Arg0: arg0, Arg1: arg1})
+	return (s.DecodeStub)(arg0, arg1)
+}
+
+// DecodeCalls returns a slice of calls made to Decode. Each element
+// of the slice represents the parameters that were provided.
+func (s *Decoder) DecodeCalls() []struct {
+	Arg0 []byte
+	Arg1 interface{}
+} {
+	return s.decodeCalls
+}
+
+// DecodeCallCount returns the number of calls made to Decode.
+func (s *Decoder) DecodeCallCount() int {
+	return len(s.decodeCalls)
+}
+
+// DecodeCalled reports whether Decode has been called.
+func (s *Decoder) DecodeCalled() bool {
+	return s.DecodeCallCount() > 0
+}
+
+// DecodeLastCall returns the parameters of the most recent call to Decode,
+// and whether there has been one.
+func (s *Decoder) DecodeLastCall() (struct {
+	Arg0 []byte
+	Arg1 interface{}
+}, bool) {
+	calls := s.DecodeCalls()
+	if len(calls) == 0 {
+		return struct {
+			Arg0 []byte
+			Arg1 interface{}
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Skip delegates its behavior to the field SkipStub.
+func (s *Decoder) Skip(arg0 int, n int) int {
+	if s.SkipStub == nil {
+		panic("Decoder.Skip: nil method stub")
+	}
+	s.skipCalls = append(s.skipCalls, struct {
+		Arg0 int
+		N    int
+	}{Arg0: arg0, N: n})
+	return (s.SkipStub)(arg0, n)
+}
+
+// SkipCalls returns a slice of calls made to Skip. Each element
+// of the slice represents the parameters that were provided.
+func (s *Decoder) SkipCalls() []struct {
+	Arg0 int
+	N    int
+} {
+	return s.skipCalls
+}
+
+// SkipCallCount returns the number of calls made to Skip.
+func (s *Decoder) SkipCallCount() int {
+	return len(s.skipCalls)
+}
+
+// SkipCalled reports whether Skip has been called.
+func (s *Decoder) SkipCalled() bool {
+	return s.SkipCallCount() > 0
+}
+
+// SkipLastCall returns the parameters of the most recent call to Skip,
+// and whether there has been one.
+func (s *Decoder) SkipLastCall() (struct {
+	Arg0 int
+	N    int
+}, bool) {
+	calls := s.SkipCalls()
+	if len(calls) == 0 {
+		return struct {
+			Arg0 int
+			N    int
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Decoder) Reset() {
+	s.decodeCalls = nil
+	s.skipCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ unnamed.Decoder = (*Decoder)(nil)
@@ -0,0 +1,82 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"database/sql"
+	"github.com/dradtke/stubber/testdata/users"
+)
+
+// Deactivator is a stubbed implementation of users.Deactivator.
+type Deactivator struct {
+	// DeactivateStub defines the implementation for Deactivate.
+	DeactivateStub  func(db *sql.DB, userIds ...int64) error
+	deactivateCalls []struct {
+		DB      *sql.DB
+		UserIds []int64
+	}
+}
+
+// NewDeactivator returns a new Deactivator without any stubs set.
+func NewDeactivator() *Deactivator {
+	return &Deactivator{}
+}
+
+// Deactivate delegates its behavior to the field DeactivateStub.
+func (s *Deactivator) Deactivate(db *sql.DB, userIds ...int64) error {
+	if s.DeactivateStub == nil {
+		panic("Deactivator.Deactivate: nil method stub")
+	}
+	s.deactivateCalls = append(s.deactivateCalls, struct {
+		DB      *sql.DB
+		UserIds []int64
+	}{DB: db, UserIds: userIds})
+	return (s.DeactivateStub)(db, userIds...)
+}
+
+// DeactivateCalls returns a slice of calls made to Deactivate. Each element
+// of the slice represents the parameters that were provided.
+func (s *Deactivator) DeactivateCalls() []struct {
+	DB      *sql.DB
+	UserIds []int64
+} {
+	return s.deactivateCalls
+}
+
+// DeactivateCallCount returns the number of calls made to Deactivate.
+func (s *Deactivator) DeactivateCallCount() int {
+	return len(s.deactivateCalls)
+}
+
+// DeactivateCalled reports whether Deactivate has been called.
+func (s *Deactivator) DeactivateCalled() bool {
+	return s.DeactivateCallCount() > 0
+}
+
+// DeactivateLastCall returns the parameters of the most recent call to Deactivate,
+// and whether there has been one.
+func (s *Deactivator) DeactivateLastCall() (struct {
+	DB      *sql.DB
+	UserIds []int64
+}, bool) {
+	calls := s.DeactivateCalls()
+	if len(calls) == 0 {
+		return struct {
+			DB      *sql.DB
+			UserIds []int64
+		}{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Deactivator) Reset() {
+	s.deactivateCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ users.Deactivator = (*Deactivator)(nil)
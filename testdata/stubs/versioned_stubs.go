@@ -0,0 +1,68 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package stubs
+
+import (
+	"github.com/dradtke/stubber/testdata/versioned"
+	"github.com/dradtke/stubber/testdata/versioned/bar/v1"
+	foov1 "github.com/dradtke/stubber/testdata/versioned/foo/v1"
+)
+
+// Translator is a stubbed implementation of versioned.Translator.
+type Translator struct {
+	// TranslateStub defines the implementation for Translate.
+	TranslateStub  func(req *foov1.Request) (*v1.Request, error)
+	translateCalls []struct{ Req *foov1.Request }
+}
+
+// NewTranslator returns a new Translator without any stubs set.
+func NewTranslator() *Translator {
+	return &Translator{}
+}
+
+// Translate delegates its behavior to the field TranslateStub.
+func (s *Translator) Translate(req *foov1.Request) (*v1.Request, error) {
+	if s.TranslateStub == nil {
+		panic("Translator.Translate: nil method stub")
+	}
+	s.translateCalls = append(s.translateCalls, struct{ Req *foov1.Request }{Req: req})
+	return (s.TranslateStub)(req)
+}
+
+// TranslateCalls returns a slice of calls made to Translate. Each element
+// of the slice represents the parameters that were provided.
+func (s *Translator) TranslateCalls() []struct{ Req *foov1.Request } {
+	return s.translateCalls
+}
+
+// TranslateCallCount returns the number of calls made to Translate.
+func (s *Translator) TranslateCallCount() int {
+	return len(s.translateCalls)
+}
+
+// TranslateCalled reports whether Translate has been called.
+func (s *Translator) TranslateCalled() bool {
+	return s.TranslateCallCount() > 0
+}
+
+// TranslateLastCall returns the parameters of the most recent call to Translate,
+// and whether there has been one.
+func (s *Translator) TranslateLastCall() (struct{ Req *foov1.Request }, bool) {
+	calls := s.TranslateCalls()
+	if len(calls) == 0 {
+		return struct{ Req *foov1.Request }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Translator) Reset() {
+	s.translateCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ versioned.Translator = (*Translator)(nil)
@@ -0,0 +1,67 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"context"
+	"example.com/testify/source"
+	"github.com/stretchr/testify/mock"
+)
+
+// Store is a mock of source.Store for use with
+// github.com/stretchr/testify/mock.
+type Store struct {
+	mock.Mock
+}
+
+// NewStore returns a new Store without any expectations set.
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Delete reports the call to the embedded mock.Mock.
+func (s *Store) Delete(key string) {
+	s.Called(key)
+}
+
+// Get reports the call to the embedded mock.Mock, returning the
+// results of the expected call it matches.
+func (s *Store) Get(ctx context.Context, key string) (*source.Item, error) {
+	args := s.Called(ctx, key)
+	var r0 *source.Item
+	if v, ok := args.Get(0).(*source.Item); ok {
+		r0 = v
+	}
+	return r0, args.Error(1)
+}
+
+// Keys reports the call to the embedded mock.Mock, returning the
+// results of the expected call it matches.
+func (s *Store) Keys(prefixes ...string) []string {
+	args := s.Called(prefixes)
+	var r0 []string
+	if v, ok := args.Get(0).([]string); ok {
+		r0 = v
+	}
+	return r0
+}
+
+// Len reports the call to the embedded mock.Mock, returning the
+// results of the expected call it matches.
+func (s *Store) Len() int {
+	args := s.Called()
+	return args.Int(0)
+}
+
+// Lookup reports the call to the embedded mock.Mock, returning the
+// results of the expected call it matches.
+func (s *Store) Lookup(key string) (source.Item, bool) {
+	args := s.Called(key)
+	return args.Get(0).(source.Item), args.Bool(1)
+}
+
+// Compile-time check that the mock matches the interface.
+var _ source.Store = (*Store)(nil)
@@ -0,0 +1,18 @@
+package source
+
+import "context"
+
+// Item is a struct returned by value and by pointer.
+type Item struct {
+	Key, Value string
+}
+
+// Store's results cover each way a testify mock takes them from its
+// arguments: a dedicated accessor, a nillable type and a value type.
+type Store interface {
+	Get(ctx context.Context, key string) (*Item, error)
+	Lookup(key string) (Item, bool)
+	Keys(prefixes ...string) []string
+	Len() int
+	Delete(key string)
+}
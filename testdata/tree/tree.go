@@ -0,0 +1,13 @@
+package tree
+
+// TreeNode is a concrete type used by Tree's methods.
+type TreeNode struct {
+	Key      string
+	Children []*TreeNode
+}
+
+type Tree interface {
+	Root() *TreeNode
+	Find(from *TreeNode, key string) (*TreeNode, bool)
+	Insert(parent *TreeNode, node TreeNode) *TreeNode
+}
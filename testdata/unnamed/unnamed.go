@@ -0,0 +1,7 @@
+package unnamed
+
+// Decoder's parameters are unnamed or blank, so the stub has to name them.
+type Decoder interface {
+	Decode([]byte, interface{}) error
+	Skip(_ int, n int) int
+}
@@ -0,0 +1,12 @@
+package unrecordable
+
+type Filter struct {
+	Labels map[string]string
+}
+
+type Events interface {
+	Subscribe(topic string, ch chan<- string) error
+	Handle(topic string, fn func(string)) error
+	Lookup(filters []Filter) []string
+	Publish(topic string, data []byte) error
+}
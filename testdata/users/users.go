@@ -0,0 +1,9 @@
+package users
+
+import "database/sql"
+
+// Deactivator's method is variadic, so the stub must forward its last
+// argument with "...".
+type Deactivator interface {
+	Deactivate(db *sql.DB, userIds ...int64) error
+}
@@ -0,0 +1,125 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package valuereceiver
+
+import (
+	"github.com/dradtke/stubber/testdata/bank"
+	"io"
+)
+
+// Account is a stubbed implementation of bank.Account.
+type Account struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub func() int
+	// CloseStub defines the implementation for Close.
+	CloseStub func() error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub func(_s string)
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub func(w io.Writer)
+}
+
+// NewAccount returns a new Account without any stubs set.
+func NewAccount() *Account {
+	return &Account{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s Account) Balance() int {
+	if s.BalanceStub == nil {
+		panic("Account.Balance: nil method stub")
+	}
+	return (s.BalanceStub)()
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s Account) Close() error {
+	if s.CloseStub == nil {
+		panic("Account.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s Account) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("Account.SetNickname: nil method stub")
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s Account) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("Account.Summarize: nil method stub")
+	}
+	(s.SummarizeStub)(w)
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.Account = Account{}
+
+// WithdrawableAccount is a stubbed implementation of bank.WithdrawableAccount.
+type WithdrawableAccount struct {
+	// BalanceStub defines the implementation for Balance.
+	BalanceStub func() int
+	// CloseStub defines the implementation for Close.
+	CloseStub func() error
+	// SetNicknameStub defines the implementation for SetNickname.
+	SetNicknameStub func(_s string)
+	// SummarizeStub defines the implementation for Summarize.
+	SummarizeStub func(w io.Writer)
+	// WithdrawStub defines the implementation for Withdraw.
+	WithdrawStub func(amount int) (int, error)
+}
+
+// NewWithdrawableAccount returns a new WithdrawableAccount without any stubs set.
+func NewWithdrawableAccount() *WithdrawableAccount {
+	return &WithdrawableAccount{}
+}
+
+// Balance delegates its behavior to the field BalanceStub.
+func (s WithdrawableAccount) Balance() int {
+	if s.BalanceStub == nil {
+		panic("WithdrawableAccount.Balance: nil method stub")
+	}
+	return (s.BalanceStub)()
+}
+
+// Close delegates its behavior to the field CloseStub.
+func (s WithdrawableAccount) Close() error {
+	if s.CloseStub == nil {
+		panic("WithdrawableAccount.Close: nil method stub")
+	}
+	return (s.CloseStub)()
+}
+
+// SetNickname delegates its behavior to the field SetNicknameStub.
+func (s WithdrawableAccount) SetNickname(_s string) {
+	if s.SetNicknameStub == nil {
+		panic("WithdrawableAccount.SetNickname: nil method stub")
+	}
+	(s.SetNicknameStub)(_s)
+}
+
+// Summarize delegates its behavior to the field SummarizeStub.
+func (s WithdrawableAccount) Summarize(w io.Writer) {
+	if s.SummarizeStub == nil {
+		panic("WithdrawableAccount.Summarize: nil method stub")
+	}
+	(s.SummarizeStub)(w)
+}
+
+// Withdraw delegates its behavior to the field WithdrawStub.
+func (s WithdrawableAccount) Withdraw(amount int) (int, error) {
+	if s.WithdrawStub == nil {
+		panic("WithdrawableAccount.Withdraw: nil method stub")
+	}
+	return (s.WithdrawStub)(amount)
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ bank.WithdrawableAccount = WithdrawableAccount{}
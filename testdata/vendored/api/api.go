@@ -0,0 +1,8 @@
+package api
+
+import "example.com/dep"
+
+// Auth refers to a type from a vendored package.
+type Auth interface {
+	Login(user string) (*dep.Token, error)
+}
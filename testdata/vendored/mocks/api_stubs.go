@@ -0,0 +1,67 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"example.com/dep"
+	"example.com/vendored/api"
+)
+
+// Auth is a stubbed implementation of api.Auth.
+type Auth struct {
+	// LoginStub defines the implementation for Login.
+	LoginStub  func(user string) (*dep.Token, error)
+	loginCalls []struct{ User string }
+}
+
+// NewAuth returns a new Auth without any stubs set.
+func NewAuth() *Auth {
+	return &Auth{}
+}
+
+// Login delegates its behavior to the field LoginStub.
+func (s *Auth) Login(user string) (*dep.Token, error) {
+	if s.LoginStub == nil {
+		panic("Auth.Login: nil method stub")
+	}
+	s.loginCalls = append(s.loginCalls, struct{ User string }{User: user})
+	return (s.LoginStub)(user)
+}
+
+// LoginCalls returns a slice of calls made to Login. Each element
+// of the slice represents the parameters that were provided.
+func (s *Auth) LoginCalls() []struct{ User string } {
+	return s.loginCalls
+}
+
+// LoginCallCount returns the number of calls made to Login.
+func (s *Auth) LoginCallCount() int {
+	return len(s.loginCalls)
+}
+
+// LoginCalled reports whether Login has been called.
+func (s *Auth) LoginCalled() bool {
+	return s.LoginCallCount() > 0
+}
+
+// LoginLastCall returns the parameters of the most recent call to Login,
+// and whether there has been one.
+func (s *Auth) LoginLastCall() (struct{ User string }, bool) {
+	calls := s.LoginCalls()
+	if len(calls) == 0 {
+		return struct{ User string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Auth) Reset() {
+	s.loginCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ api.Auth = (*Auth)(nil)
@@ -0,0 +1,6 @@
+package v1
+
+// Request is version 1 of foo's request.
+type Request struct {
+	ID string
+}
@@ -0,0 +1,12 @@
+package versioned
+
+import (
+	barv1 "github.com/dradtke/stubber/testdata/versioned/bar/v1"
+	foov1 "github.com/dradtke/stubber/testdata/versioned/foo/v1"
+)
+
+// Translator refers to two different packages named v1, so one of them must
+// be imported with an alias.
+type Translator interface {
+	Translate(req *foov1.Request) (*barv1.Request, error)
+}
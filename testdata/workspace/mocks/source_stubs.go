@@ -0,0 +1,105 @@
+// This file was generated by stubber; DO NOT EDIT
+
+//go:build !nostubs
+// +build !nostubs
+
+package mocks
+
+import (
+	"example.com/source"
+)
+
+// Store is a stubbed implementation of source.Store.
+type Store struct {
+	// GetStub defines the implementation for Get.
+	GetStub  func(key string) (source.Item, error)
+	getCalls []struct{ Key string }
+	// PutStub defines the implementation for Put.
+	PutStub  func(item source.Item) error
+	putCalls []struct{ Item source.Item }
+}
+
+// NewStore returns a new Store without any stubs set.
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Get delegates its behavior to the field GetStub.
+func (s *Store) Get(key string) (source.Item, error) {
+	if s.GetStub == nil {
+		panic("Store.Get: nil method stub")
+	}
+	s.getCalls = append(s.getCalls, struct{ Key string }{Key: key})
+	return (s.GetStub)(key)
+}
+
+// GetCalls returns a slice of calls made to Get. Each element
+// of the slice represents the parameters that were provided.
+func (s *Store) GetCalls() []struct{ Key string } {
+	return s.getCalls
+}
+
+// GetCallCount returns the number of calls made to Get.
+func (s *Store) GetCallCount() int {
+	return len(s.getCalls)
+}
+
+// GetCalled reports whether Get has been called.
+func (s *Store) GetCalled() bool {
+	return s.GetCallCount() > 0
+}
+
+// GetLastCall returns the parameters of the most recent call to Get,
+// and whether there has been one.
+func (s *Store) GetLastCall() (struct{ Key string }, bool) {
+	calls := s.GetCalls()
+	if len(calls) == 0 {
+		return struct{ Key string }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Put delegates its behavior to the field PutStub.
+func (s *Store) Put(item source.Item) error {
+	if s.PutStub == nil {
+		panic("Store.Put: nil method stub")
+	}
+	s.putCalls = append(s.putCalls, struct{ Item source.Item }{Item: item})
+	return (s.PutStub)(item)
+}
+
+// PutCalls returns a slice of calls made to Put. Each element
+// of the slice represents the parameters that were provided.
+func (s *Store) PutCalls() []struct{ Item source.Item } {
+	return s.putCalls
+}
+
+// PutCallCount returns the number of calls made to Put.
+func (s *Store) PutCallCount() int {
+	return len(s.putCalls)
+}
+
+// PutCalled reports whether Put has been called.
+func (s *Store) PutCalled() bool {
+	return s.PutCallCount() > 0
+}
+
+// PutLastCall returns the parameters of the most recent call to Put,
+// and whether there has been one.
+func (s *Store) PutLastCall() (struct{ Item source.Item }, bool) {
+	calls := s.PutCalls()
+	if len(calls) == 0 {
+		return struct{ Item source.Item }{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset clears the calls recorded by s, so that it can be reused,
+// e.g. across the cases of a table-driven test.
+func (s *Store) Reset() {
+	s.getCalls = nil
+	s.putCalls = nil
+}
+
+// Compile-time check that the implementation matches the interface.
+var _ source.Store = (*Store)(nil)
@@ -0,0 +1,13 @@
+package source
+
+//go:generate stubber -output ../mocks
+
+type Item struct {
+	Key   string
+	Value []byte
+}
+
+type Store interface {
+	Get(key string) (Item, error)
+	Put(item Item) error
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"go/types"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// TestifyStyle is the name of the Generator producing mocks for
+// github.com/stretchr/testify/mock, in place of stubber's own stubs. Options
+// that shape stubber's own stubs don't apply to them.
+const TestifyStyle = "testify"
+
+// testifyMockPath is the import path of the package embedded by mocks in
+// TestifyStyle.
+const testifyMockPath = "github.com/stretchr/testify/mock"
+
+var testifyTemplate = template.Must(template.New("").Parse(`{{.HeaderComment}}
+{{range .BuildLines}}
+{{.}}
+{{- end}}
+
+package {{.OutputName}}
+
+import (
+	{{range .Imports}}{{with .Alias}}{{.}} {{end}}"{{.Path}}"
+	{{end}}
+)
+
+{{range $interface := .Interfaces}}
+{{- if .Concrete}}
+// {{.InterfaceName}} is the method set of {{.QualName}}, which {{.ImplName}}
+// implements.
+type {{.InterfaceName}} interface {
+	{{- range .Funcs}}
+	{{.Name}}{{.ParamsString}} {{.ResultsString}}
+	{{- end}}
+}
+{{end}}
+// {{.ImplName}} is a mock of {{.QualName}} for use with
+// github.com/stretchr/testify/mock.
+type {{.ImplName}}{{.TypeParamsDecl}} struct {
+	mock.Mock
+}
+
+// {{.ConstructorName}} returns a new {{.ImplName}} without any expectations set.
+func {{.ConstructorName}}{{.TypeParamsDecl}}() *{{.TypeName}} {
+	return &{{.TypeName}}{}
+}
+{{range .Funcs}}
+{{.DocComment}}// {{.Name}} reports the call to the embedded mock.Mock{{if .HasResults}}, returning the
+// results of the expected call it matches{{end}}.
+func ({{.Receiver}} *{{$interface.TypeName}}) {{.Name}}{{.ParamsString}} {{.ResultsString}} {
+	{{if .HasResults}}{{.ArgsName}} := {{end}}{{.Receiver}}.Called({{.CalledArgs}})
+	{{- range .TestifyResults}}
+	{{- with .Decl}}
+	{{.}}
+	{{- end}}
+	{{- end}}
+	{{- if .HasResults}}
+	return {{.TestifyReturn}}
+	{{- end}}
+}
+{{end}}
+{{- if not .Partial}}
+// Compile-time check that the mock matches the interface.
+{{if .TypeParams -}}
+func _{{.TypeParamsDecl}}() {
+	var _ {{.InterfaceName}}{{.TypeArgs}} = (*{{.TypeName}})(nil)
+}
+{{- else -}}
+var _ {{.InterfaceName}} = (*{{.ImplName}})(nil)
+{{- end}}
+{{end}}
+{{end}}`))
+
+func generateTestify(p *Package) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := testifyTemplate.Execute(&buf, p); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ArgsName returns the name of the variable holding the mock.Arguments
+// returned for a call to f.
+func (f *Func) ArgsName() string {
+	return f.localName("args")
+}
+
+// CalledArgs returns f's parameter names as arguments to mock.Mock.Called. A
+// variadic parameter is passed as a single slice, so expectations match it as
+// one argument.
+func (f *Func) CalledArgs() string {
+	names := make([]string, f.Signature.Params().Len())
+	for i := range names {
+		names[i] = f.paramName(i)
+	}
+	return strings.Join(names, ", ")
+}
+
+// TestifyResult is how one of f's results is taken from the mock.Arguments of
+// a call in TestifyStyle.
+type TestifyResult struct {
+	// Decl declares and sets a variable holding the result, if it can't be
+	// taken with a single expression.
+	Decl string
+	// Expr is an expression for the result.
+	Expr string
+}
+
+// TestifyResults returns how each of f's results is taken from the
+// mock.Arguments of a call. Results with a dedicated accessor, e.g. Error or
+// Int, use it; other results are asserted from Get, tolerating nil for types
+// that can be nil.
+func (f *Func) TestifyResults() []TestifyResult {
+	args := f.ArgsName()
+	results := f.Signature.Results()
+	parts := make([]TestifyResult, results.Len())
+	for i := range parts {
+		t := results.At(i).Type()
+		index := strconv.Itoa(i)
+		if accessor := testifyAccessor(t); accessor != "" {
+			parts[i].Expr = args + "." + accessor + "(" + index + ")"
+			continue
+		}
+		typeString := f.typeString(t)
+		if !nillable(t) {
+			parts[i].Expr = args + ".Get(" + index + ").(" + typeString + ")"
+			continue
+		}
+		name := f.localName("r" + index)
+		parts[i].Decl = "var " + name + " " + typeString + "\n" +
+			"if v, ok := " + args + ".Get(" + index + ").(" + typeString + "); ok {\n" +
+			name + " = v\n" +
+			"}"
+		parts[i].Expr = name
+	}
+	return parts
+}
+
+// TestifyReturn returns the expressions for f's results, separated by
+// commas.
+func (f *Func) TestifyReturn() string {
+	results := f.TestifyResults()
+	exprs := make([]string, len(results))
+	for i, result := range results {
+		exprs[i] = result.Expr
+	}
+	return strings.Join(exprs, ", ")
+}
+
+// testifyAccessor returns the name of the mock.Arguments method returning a
+// value of type t, or "" if there's none. Only unnamed types qualify, since
+// the accessors return the predeclared types.
+func testifyAccessor(t types.Type) string {
+	if types.Identical(t, errorType) {
+		return "Error"
+	}
+	basic, ok := t.(*types.Basic)
+	if !ok {
+		return ""
+	}
+	switch basic.Kind() {
+	case types.Bool:
+		return "Bool"
+	case types.Int:
+		return "Int"
+	case types.String:
+		return "String"
+	}
+	return ""
+}
+
+// nillable reports whether nil is a value of type t.
+func nillable(t types.Type) bool {
+	if _, ok := t.(*types.TypeParam); ok {
+		return false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return true
+	case *types.Basic:
+		return u.Kind() == types.UnsafePointer
+	}
+	return false
+}